@@ -0,0 +1,113 @@
+// Command recompute_names recalculates the precomputed columns of names_miracle
+// (satnum, shanum, t_sat, t_sha and k_*) with the current sat_nums, sha_nums,
+// klakini and number_pairs tables, and updates the rows that changed.
+//
+//	go run ./cmd/recompute_names -dry-run -report report.json
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	"numberniceic/internal/adapters/cache"
+	"numberniceic/internal/adapters/repository"
+	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/service"
+
+	"github.com/joho/godotenv"
+	_ "github.com/lib/pq"
+)
+
+func main() {
+	batch := flag.Int("batch", service.DefaultRecomputeBatchSize, "Rows read per batch")
+	dryRun := flag.Bool("dry-run", false, "Report changes without writing them")
+	samples := flag.Int("samples", 20, "Changed rows to print and keep in the report")
+	reportPath := flag.String("report", "", "Write the full report as JSON to this file")
+	flag.Parse()
+
+	if err := godotenv.Load(); err != nil {
+		if err := godotenv.Load("../../.env"); err != nil {
+			log.Println("Warning: No .env file found, relying on environment variables.")
+		}
+	}
+
+	db, err := repository.OpenPostgres()
+	if err != nil {
+		log.Fatalf("Failed to connect to DB: %v", err)
+	}
+	defer db.Close()
+
+	caches, err := cache.NewEngineCaches(db)
+	if err != nil {
+		log.Fatalf("Failed to load cache: %v", err)
+	}
+	engine := caches.Engine()
+
+	recompute := service.NewNamesMiracleRecomputeService(
+		repository.NewPostgresNamesMiracleRepository(db, caches.Ruleset),
+		service.NewNumerologyService(engine),
+	)
+
+	if *dryRun {
+		fmt.Println("Dry run: no rows will be updated.")
+	}
+	report, err := recompute.Run(service.RecomputeOptions{BatchSize: *batch, DryRun: *dryRun, Samples: *samples}, func(p domain.RecomputeProgress) {
+		fmt.Printf("\rScanned %d/%d, changed %d", p.Scanned, p.Total, p.Changed)
+	})
+	fmt.Println()
+
+	printReport(report)
+	if *reportPath != "" {
+		writeReport(*reportPath, report)
+	}
+	if report.Updated > 0 {
+		// Running servers keep names_miracle in memory; tell them to reload it.
+		if err := cache.Notify(db, "names_miracle"); err != nil {
+			log.Printf("Warning: servers were not told to reload names_miracle, reload it from /admin/caches: %v", err)
+		}
+	}
+	if err != nil {
+		log.Fatalf("Recompute failed: %v", err)
+	}
+}
+
+func printReport(r *domain.RecomputeReport) {
+	fmt.Printf("Scanned %d rows, %d changed, %d updated, %d invalid (%s)\n",
+		r.Scanned, r.Changed, r.Updated, len(r.Invalid), r.FinishedAt.Sub(r.StartedAt).Round(1e6))
+
+	columns := make([]string, 0, len(r.ColumnCounts))
+	for col := range r.ColumnCounts {
+		columns = append(columns, col)
+	}
+	sort.Strings(columns)
+	for _, col := range columns {
+		fmt.Printf("  %-14s %d\n", col, r.ColumnCounts[col])
+	}
+
+	for _, d := range r.Samples {
+		fmt.Printf("#%d %s\n", d.NameID, d.Name)
+		for _, c := range d.Changes {
+			fmt.Printf("    %-12s %s -> %s\n", c.Column, c.Before, c.After)
+		}
+	}
+	for _, d := range r.Invalid {
+		fmt.Printf("invalid #%d %s: %s\n", d.NameID, d.Name, d.Changes[0].After)
+	}
+}
+
+func writeReport(path string, r *domain.RecomputeReport) {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		log.Printf("Failed to encode report: %v", err)
+		return
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		log.Printf("Failed to write report: %v", err)
+		return
+	}
+	fmt.Printf("Report written to %s\n", path)
+}
@@ -0,0 +1,129 @@
+// Command verify compares every names_miracle row and every saved name with the
+// live engine and writes the mismatches, grouped by cause, as CSV or JSON.
+//
+//	go run ./cmd/verify -format csv -out mismatches.csv
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+
+	"numberniceic/internal/adapters/cache"
+	"numberniceic/internal/adapters/repository"
+	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/service"
+
+	"github.com/joho/godotenv"
+	_ "github.com/lib/pq"
+)
+
+func main() {
+	format := flag.String("format", "csv", "Report format: csv or json")
+	out := flag.String("out", "", "Write the report to this file instead of stdout")
+	batch := flag.Int("batch", service.DefaultRecomputeBatchSize, "names_miracle rows read per batch")
+	flag.Parse()
+
+	if *format != "csv" && *format != "json" {
+		log.Fatalf("Unknown format %q, use csv or json", *format)
+	}
+
+	if err := godotenv.Load(); err != nil {
+		if err := godotenv.Load("../../.env"); err != nil {
+			log.Println("Warning: No .env file found, relying on environment variables.")
+		}
+	}
+
+	db, err := repository.OpenPostgres()
+	if err != nil {
+		log.Fatalf("Failed to connect to DB: %v", err)
+	}
+	defer db.Close()
+
+	caches, err := cache.NewEngineCaches(db)
+	if err != nil {
+		log.Fatalf("Failed to load cache: %v", err)
+	}
+	engine := caches.Engine()
+
+	verifier := service.NewConsistencyService(
+		repository.NewPostgresNamesMiracleRepository(db, caches.Ruleset),
+		repository.NewPostgresSavedNameRepository(db),
+		engine,
+	)
+	report, err := verifier.Verify(*batch)
+	if err != nil {
+		log.Fatalf("Verify failed: %v", err)
+	}
+
+	// Group by cause, then source and row.
+	sort.SliceStable(report.Mismatches, func(i, j int) bool {
+		a, b := report.Mismatches[i], report.Mismatches[j]
+		if a.Cause != b.Cause {
+			return a.Cause < b.Cause
+		}
+		if a.Source != b.Source {
+			return a.Source < b.Source
+		}
+		return a.ID < b.ID
+	})
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatalf("Failed to create report: %v", err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if *format == "json" {
+		err = writeJSON(w, report)
+	} else {
+		err = writeCSV(w, report)
+	}
+	if err != nil {
+		log.Fatalf("Failed to write report: %v", err)
+	}
+
+	printSummary(report)
+}
+
+func writeJSON(w io.Writer, report *domain.ConsistencyReport) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+func writeCSV(w io.Writer, report *domain.ConsistencyReport) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"cause", "source", "id", "name", "day", "field", "stored", "live"})
+	for _, m := range report.Mismatches {
+		cw.Write([]string{m.Cause, m.Source, strconv.Itoa(m.ID), m.Name, m.Day, m.Field, m.Stored, m.Live})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// printSummary goes to stderr so it never mixes with a report written to stdout.
+func printSummary(report *domain.ConsistencyReport) {
+	fmt.Fprintf(os.Stderr, "Checked %d names_miracle rows and %d saved names against ruleset v%d\n",
+		report.CheckedNamesMiracle, report.CheckedSavedNames, report.RulesetVersion)
+	causes := make([]string, 0, len(report.ByCause))
+	for cause := range report.ByCause {
+		causes = append(causes, cause)
+	}
+	sort.Strings(causes)
+	for _, cause := range causes {
+		fmt.Fprintf(os.Stderr, "  %-16s %d rows\n", cause, report.ByCause[cause])
+	}
+	if len(causes) == 0 {
+		fmt.Fprintln(os.Stderr, "No mismatches found.")
+	}
+}
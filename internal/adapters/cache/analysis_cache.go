@@ -0,0 +1,136 @@
+package cache
+
+import (
+	"container/list"
+	"numberniceic/internal/core/domain"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// AnalysisKey identifies one name search of an analysis. Section tells the
+// searches of one analysis apart (similar names, best names) and Limit is the
+// row limit, which depends on VIP status.
+type AnalysisKey struct {
+	Name           string // Normalised input name
+	Day            string
+	AllowKlakini   bool
+	GoodOnly       bool
+	Section        string
+	Limit          int
+	RulesetVersion int
+	Search         domain.NameSearchOptions
+}
+
+// AnalysisCacheStats reports the size and hit rate of an AnalysisCache.
+type AnalysisCacheStats struct {
+	Size     int    `json:"size"`
+	MaxSize  int    `json:"max_size"`
+	TTL      string `json:"ttl"`
+	Hits     int64  `json:"hits"`
+	Misses   int64  `json:"misses"`
+	Rejected int64  `json:"rejected"` // Results computed across an invalidation, not stored
+}
+
+// AnalysisCache keeps the scored results of recent name searches, so popular
+// and sample names do not repeat the Postgres similarity queries. Entries
+// expire after ttl, the least recently used go first beyond maxSize, and the
+// whole cache is dropped when reference data reloads.
+type AnalysisCache struct {
+	maxSize int
+	ttl     time.Duration
+
+	mu         sync.Mutex
+	entries    map[AnalysisKey]*list.Element
+	order      *list.List // Front is the most recently used
+	generation uint64     // Bumped by Invalidate
+
+	hits, misses, rejected atomic.Int64
+}
+
+type analysisEntry struct {
+	key     AnalysisKey
+	names   []domain.SimilarNameResult
+	expires time.Time
+}
+
+func NewAnalysisCache(maxSize int, ttl time.Duration) *AnalysisCache {
+	return &AnalysisCache{
+		maxSize: maxSize,
+		ttl:     ttl,
+		entries: make(map[AnalysisKey]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Lookup returns the cached names of key. On a miss fill computes them, and
+// they are stored unless the cache was invalidated while fill ran. Callers get
+// their own copy of the slice to modify.
+func (c *AnalysisCache) Lookup(key AnalysisKey, fill func() ([]domain.SimilarNameResult, error)) ([]domain.SimilarNameResult, error) {
+	c.mu.Lock()
+	if el, ok := c.entries[key]; ok {
+		e := el.Value.(*analysisEntry)
+		if time.Now().Before(e.expires) {
+			c.order.MoveToFront(el)
+			c.mu.Unlock()
+			c.hits.Add(1)
+			return append([]domain.SimilarNameResult(nil), e.names...), nil
+		}
+		c.remove(el)
+	}
+	generation := c.generation
+	c.mu.Unlock()
+	c.misses.Add(1)
+
+	names, err := fill()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		c.rejected.Add(1)
+		return names, nil
+	}
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+	c.entries[key] = c.order.PushFront(&analysisEntry{
+		key:     key,
+		names:   append([]domain.SimilarNameResult(nil), names...),
+		expires: time.Now().Add(c.ttl),
+	})
+	for c.order.Len() > c.maxSize {
+		c.remove(c.order.Back())
+	}
+	return names, nil
+}
+
+func (c *AnalysisCache) remove(el *list.Element) {
+	delete(c.entries, el.Value.(*analysisEntry).key)
+	c.order.Remove(el)
+}
+
+// Invalidate drops every entry, and any result being computed from the old data.
+func (c *AnalysisCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[AnalysisKey]*list.Element)
+	c.order.Init()
+	c.generation++
+}
+
+func (c *AnalysisCache) Stats() AnalysisCacheStats {
+	c.mu.Lock()
+	size := c.order.Len()
+	c.mu.Unlock()
+	return AnalysisCacheStats{
+		Size:     size,
+		MaxSize:  c.maxSize,
+		TTL:      c.ttl.String(),
+		Hits:     c.hits.Load(),
+		Misses:   c.misses.Load(),
+		Rejected: c.rejected.Load(),
+	}
+}
@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"numberniceic/internal/adapters/repository"
+	"numberniceic/internal/core/numerology"
+)
+
+// EngineCaches are the reference caches a numerology.Engine reads. The server
+// registers them for reload; the CLIs only build the engine.
+type EngineCaches struct {
+	Sat        *NumerologyCache
+	Sha        *NumerologyCache
+	LatinSat   *NumerologyCache
+	LatinSha   *NumerologyCache
+	Klakini    *KlakiniCache
+	Taksa      *TaksaCache
+	Pairs      *NumberPairCache
+	Categories *NumberCategoryCache
+	Ruleset    *ScoringRulesetCache
+}
+
+// NewEngineCaches creates the caches of every reference table and loads them.
+// Tables that fail to load are reported in the error and retried on first use;
+// a missing ruleset only logs a warning, as the built-in defaults stand in.
+func NewEngineCaches(db *sql.DB) (*EngineCaches, error) {
+	c := &EngineCaches{
+		Sat:        NewNumerologyCache(repository.NewPostgresNumerologyRepository(db, "sat_nums")),
+		Sha:        NewNumerologyCache(repository.NewPostgresNumerologyRepository(db, "sha_nums")),
+		LatinSat:   NewNumerologyCache(repository.NewPostgresNumerologyRepository(db, "latin_sat_nums")),
+		LatinSha:   NewNumerologyCache(repository.NewPostgresNumerologyRepository(db, "latin_sha_nums")),
+		Klakini:    NewKlakiniCache(repository.NewPostgresKlakiniRepository(db)),
+		Taksa:      NewTaksaCache(repository.NewPostgresTaksaRepository(db)),
+		Pairs:      NewNumberPairCache(repository.NewPostgresNumberPairRepository(db)),
+		Categories: NewNumberCategoryCache(repository.NewPostgresNumberCategoryRepository(db)),
+		Ruleset:    NewScoringRulesetCache(repository.NewPostgresScoringRulesetRepository(db), numerology.DefaultRuleset()),
+	}
+	if err := c.Ruleset.EnsureLoaded(); err != nil {
+		log.Printf("Warning: scoring ruleset not loaded, using built-in defaults: %v", err)
+	}
+
+	var errs []error
+	for name, cache := range map[string]interface{ EnsureLoaded() error }{
+		"sat_nums":          c.Sat,
+		"sha_nums":          c.Sha,
+		"latin_sat_nums":    c.LatinSat,
+		"latin_sha_nums":    c.LatinSha,
+		"kakis_day":         c.Klakini,
+		"taksa_day":         c.Taksa,
+		"numbers":           c.Pairs,
+		"number_categories": c.Categories,
+	} {
+		if err := cache.EnsureLoaded(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+	return c, errors.Join(errs...)
+}
+
+// Engine builds the numerology engine over the caches.
+func (c *EngineCaches) Engine() *numerology.Engine {
+	return numerology.NewEngine(c.Sat, c.Sha, c.LatinSat, c.LatinSha, c.Klakini, c.Taksa, c.Pairs, c.Categories, c.Ruleset)
+}
@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"numberniceic/internal/core/numerology"
 	"numberniceic/internal/core/ports"
 	"strings"
 	"sync"
@@ -9,9 +10,9 @@ import (
 type KlakiniCache struct {
 	repository ports.KlakiniRepository
 	// cache maps a day (e.g., "monday") to a map of its bad characters for quick lookups.
-	cache map[string]map[rune]bool
-	once  sync.Once
-	mu    sync.RWMutex
+	cache  map[string]map[rune]bool
+	loader loader
+	mu     sync.RWMutex
 }
 
 func NewKlakiniCache(repository ports.KlakiniRepository) *KlakiniCache {
@@ -21,36 +22,61 @@ func NewKlakiniCache(repository ports.KlakiniRepository) *KlakiniCache {
 	}
 }
 
-func (c *KlakiniCache) loadCache() error {
-	var err error
-	c.once.Do(func() {
-		klakinis, loadErr := c.repository.GetAll()
-		if loadErr != nil {
-			err = loadErr
-			return
+// build reads the table and returns the commit that swaps the new data in.
+func (c *KlakiniCache) build() (func(), error) {
+	klakinis, err := c.repository.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	cache := make(map[string]map[rune]bool)
+	for _, k := range klakinis {
+		badCharSet, exists := cache[k.Day]
+		if !exists {
+			badCharSet = make(map[rune]bool)
+			cache[k.Day] = badCharSet
 		}
 
-		c.mu.Lock()
-		defer c.mu.Unlock()
-
-		for _, k := range klakinis {
-			badCharSet, exists := c.cache[k.Day]
-			if !exists {
-				badCharSet = make(map[rune]bool)
-				c.cache[k.Day] = badCharSet
-			}
-
-			for _, char := range k.BadChars {
-				badCharSet[char] = true
-			}
+		for _, char := range k.BadChars {
+			badCharSet[char] = true
 		}
-	})
-	return err
+	}
+
+	return func() {
+		c.mu.Lock()
+		c.cache = cache
+		c.mu.Unlock()
+	}, nil
+}
+
+func (c *KlakiniCache) loadCache() error {
+	return c.loader.swap(c.build)
+}
+
+func (c *KlakiniCache) stage() (func(), error) {
+	return c.loader.stage(c.build)
+}
+
+func (c *KlakiniCache) shareSwap(lock *sync.RWMutex) {
+	c.loader.share(lock)
+}
+
+// Snapshot returns the klakini letters loaded now, fixed for one analysis. Before
+// the first load it returns the cache itself.
+func (c *KlakiniCache) Snapshot() numerology.KlakiniProvider {
+	if !c.loader.loaded.Load() {
+		return c
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s := &KlakiniCache{cache: c.cache}
+	s.loader.loaded.Store(true)
+	return s
 }
 
 // IsKlakini checks if a character is considered "klakini" for a given day.
 func (c *KlakiniCache) IsKlakini(day string, char rune) bool {
-	if err := c.loadCache(); err != nil {
+	if err := c.EnsureLoaded(); err != nil {
 		return false
 	}
 
@@ -67,5 +93,10 @@ func (c *KlakiniCache) IsKlakini(day string, char rune) bool {
 
 // EnsureLoaded pre-warms the cache.
 func (c *KlakiniCache) EnsureLoaded() error {
-	return c.loadCache()
+	return c.loader.ensure(c.loadCache)
+}
+
+// Reload reads the klakini days again.
+func (c *KlakiniCache) Reload() error {
+	return c.loader.reload(c.loadCache)
 }
@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// loader loads a reference cache on first use and again on Reload. Loads are
+// serialized, and each cache builds its new maps before swapping them in under
+// its own lock, so readers see either the old data or the new, never a mix. A
+// failed load keeps the old data.
+type loader struct {
+	mu     sync.Mutex
+	loaded atomic.Bool
+	shared *sync.RWMutex // Registry swap lock, once the cache is registered
+}
+
+// ensure runs load unless a load has already succeeded.
+func (l *loader) ensure(load func() error) error {
+	if l.loaded.Load() {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.loaded.Load() {
+		return nil
+	}
+	return l.run(load)
+}
+
+// reload runs load even when the cache is loaded.
+func (l *loader) reload(load func() error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.run(load)
+}
+
+func (l *loader) run(load func() error) error {
+	if err := load(); err != nil {
+		return err
+	}
+	l.loaded.Store(true)
+	return nil
+}
+
+// swap builds the new data and commits it, under the registry's swap lock once
+// the cache is registered, so a pinned engine never sees it half way.
+func (l *loader) swap(build func() (func(), error)) error {
+	commit, err := build()
+	if err != nil {
+		return err
+	}
+	if l.shared != nil {
+		l.shared.Lock()
+		defer l.shared.Unlock()
+	}
+	commit()
+	return nil
+}
+
+// stage builds the new data for Registry.reload, which commits it together with
+// the other caches it reloads while holding the swap lock.
+func (l *loader) stage(build func() (func(), error)) (func(), error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	commit, err := build()
+	if err != nil {
+		return nil, err
+	}
+	return func() {
+		commit()
+		l.loaded.Store(true)
+	}, nil
+}
+
+// share makes later loads commit under the registry's swap lock.
+func (l *loader) share(lock *sync.RWMutex) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.shared = lock
+}
@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/numerology"
+	"numberniceic/internal/core/ports"
+	"strings"
+	"sync"
+)
+
+// NameDictionaryCache keeps name_dictionary in memory, keyed by kind and term,
+// for composing name meanings without a query per syllable.
+type NameDictionaryCache struct {
+	repo ports.NameDictionaryRepository
+
+	loader      loader
+	mu          sync.RWMutex
+	entries     map[string]map[string]*domain.NameDictionaryEntry
+	maxClusters int
+}
+
+func NewNameDictionaryCache(repo ports.NameDictionaryRepository) *NameDictionaryCache {
+	return &NameDictionaryCache{repo: repo}
+}
+
+func (c *NameDictionaryCache) EnsureLoaded() error {
+	return c.loader.ensure(c.loadCache)
+}
+
+// Reload reads the dictionary again and swaps it in.
+func (c *NameDictionaryCache) Reload() error {
+	return c.loader.reload(c.loadCache)
+}
+
+func (c *NameDictionaryCache) loadCache() error {
+	all, err := c.repo.GetAll()
+	if err != nil {
+		return err
+	}
+	entries := make(map[string]map[string]*domain.NameDictionaryEntry)
+	maxClusters := 0
+	for i := range all {
+		e := &all[i]
+		if entries[e.Kind] == nil {
+			entries[e.Kind] = make(map[string]*domain.NameDictionaryEntry)
+		}
+		clusters := numerology.GraphemeClusters(e.Term)
+		entries[e.Kind][strings.Join(clusters, "")] = e
+		if e.Kind != domain.DictionaryName && len(clusters) > maxClusters {
+			maxClusters = len(clusters)
+		}
+	}
+
+	c.mu.Lock()
+	c.entries, c.maxClusters = entries, maxClusters
+	c.mu.Unlock()
+	return nil
+}
+
+// Add puts new entries into the loaded dictionary without reading the table again.
+func (c *NameDictionaryCache) Add(entries ...domain.NameDictionaryEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[string]map[string]*domain.NameDictionaryEntry)
+	}
+	for i := range entries {
+		e := entries[i]
+		if c.entries[e.Kind] == nil {
+			c.entries[e.Kind] = make(map[string]*domain.NameDictionaryEntry)
+		}
+		clusters := numerology.GraphemeClusters(e.Term)
+		c.entries[e.Kind][strings.Join(clusters, "")] = &e
+		if e.Kind != domain.DictionaryName && len(clusters) > c.maxClusters {
+			c.maxClusters = len(clusters)
+		}
+	}
+}
+
+// Lookup matches term as GraphemeClusters reads it, so Latin terms ignore case.
+func (c *NameDictionaryCache) Lookup(term string, kinds ...string) *domain.NameDictionaryEntry {
+	term = strings.Join(numerology.GraphemeClusters(strings.TrimSpace(term)), "")
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, kind := range kinds {
+		if e, ok := c.entries[kind][term]; ok {
+			return e
+		}
+	}
+	return nil
+}
+
+func (c *NameDictionaryCache) MaxPartClusters() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.maxClusters
+}
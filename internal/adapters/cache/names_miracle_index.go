@@ -0,0 +1,518 @@
+package cache
+
+import (
+	"container/heap"
+	"fmt"
+	"log"
+	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/numerology"
+	"numberniceic/internal/core/ports"
+	"strings"
+	"sync"
+)
+
+// namesMiracleLoadBatch is how many rows Reload reads per query.
+const namesMiracleLoadBatch = 5000
+
+// Minimum similarity of a suggestion, as in the pg_trgm queries this index replaces.
+const (
+	similarNamesThreshold = 0.01
+	bestNamesThreshold    = 0.001
+)
+
+type namesMiracleEntry struct {
+	row       domain.SimilarNameResult // Stored columns of the row
+	keys      int                      // Number of similarity keys of row.ThName
+	soundKeys int                      // Number of phonetic similarity keys
+	deleted   bool
+}
+
+// NamesMiracleIndex keeps names_miracle in memory and answers the similar-name
+// searches without pg_trgm. Names are compared on Thai grapheme cluster trigrams
+// (see numerology.SimilarityKeys) and on their phonetic keys through inverted
+// indexes. It implements ports.NamesMiracleRepository: searches run in memory,
+// writes go to the repository and then update the index, and everything else is
+// delegated.
+type NamesMiracleIndex struct {
+	repo     ports.NamesMiracleRepository
+	rulesets ports.ScoringRulesetProvider
+	engine   *numerology.Engine // Fills the search metadata of rows not yet recomputed
+	loader   loader
+	writeMu  sync.Mutex // Held by loads and writes, so a reload never drops a write
+
+	mu            sync.RWMutex
+	entries       []*namesMiracleEntry
+	byID          map[int]int      // name_id -> index in entries
+	postings      map[string][]int // similarity key -> indexes in entries
+	soundPostings map[string][]int // phonetic similarity key -> indexes in entries
+	loaded        bool
+}
+
+func NewNamesMiracleIndex(repo ports.NamesMiracleRepository, rulesets ports.ScoringRulesetProvider, engine *numerology.Engine) *NamesMiracleIndex {
+	return &NamesMiracleIndex{repo: repo, rulesets: rulesets, engine: engine}
+}
+
+// EnsureLoaded loads the table on first use; concurrent first callers share one load.
+func (c *NamesMiracleIndex) EnsureLoaded() error {
+	return c.loader.ensure(c.load)
+}
+
+// Reload reads the whole table again.
+func (c *NamesMiracleIndex) Reload() error {
+	return c.loader.reload(c.load)
+}
+
+// load reads the whole table and swaps it in at once, so searches never see a
+// half-built index. Writes wait for it and then apply to the new index.
+func (c *NamesMiracleIndex) load() error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	var entries []*namesMiracleEntry
+	afterID := 0
+	for {
+		rows, err := c.repo.GetBatch(afterID, namesMiracleLoadBatch)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		for _, row := range rows {
+			entries = append(entries, &namesMiracleEntry{row: row})
+		}
+		afterID = rows[len(rows)-1].NameID
+	}
+
+	byID := make(map[int]int, len(entries))
+	postings := make(map[string][]int)
+	soundPostings := make(map[string][]int)
+	for i, e := range entries {
+		byID[e.row.NameID] = i
+		e.index(c.engine, postings, soundPostings, i)
+	}
+
+	c.mu.Lock()
+	c.entries, c.byID, c.postings, c.soundPostings, c.loaded = entries, byID, postings, soundPostings, true
+	c.mu.Unlock()
+	log.Printf("Names miracle index loaded: %d names, %d keys", len(entries), len(postings))
+	return nil
+}
+
+// index adds entry i to both postings. Rows not yet backfilled by
+// cmd/recompute_names get their phonetic key and search metadata computed from
+// the name, so the filters work before the first recompute.
+func (e *namesMiracleEntry) index(engine *numerology.Engine, postings, soundPostings map[string][]int, i int) {
+	if e.row.PhoneticKey == "" {
+		e.row.PhoneticKey = numerology.PhoneticKey(e.row.ThName)
+	}
+	if e.row.Syllables == 0 {
+		// Every name has a syllable; 0 is the column default of migration 026.
+		e.row.Gender = numerology.NameGender(e.row.ThName)
+		e.row.Syllables = numerology.SyllableCount(e.row.ThName)
+		e.row.FirstLetter = numerology.FirstLetter(e.row.ThName)
+	}
+	if len(e.row.CategoryStrengths) == 0 && engine != nil {
+		e.row.CategoryStrengths = engine.CategoryStrengths(e.row.SatNum, e.row.ShaNum)
+	}
+	e.keys = addPostings(postings, numerology.SimilarityKeys(e.row.ThName), i)
+	e.soundKeys = addPostings(soundPostings, numerology.PhoneticSimilarityKeys(e.row.PhoneticKey), i)
+}
+
+func addPostings(postings map[string][]int, keys []string, i int) int {
+	for _, k := range keys {
+		postings[k] = append(postings[k], i)
+	}
+	return len(keys)
+}
+
+func removePostings(postings map[string][]int, keys []string, i int) {
+	for _, k := range keys {
+		list := postings[k]
+		for j, p := range list {
+			if p == i {
+				list = append(list[:j], list[j+1:]...)
+				break
+			}
+		}
+		if len(list) == 0 {
+			delete(postings, k)
+		} else {
+			postings[k] = list
+		}
+	}
+}
+
+// GetSimilarNames returns names with a similarity above 0.01, an exact match first.
+func (c *NamesMiracleIndex) GetSimilarNames(name, day string, limit, offset int, allowKlakini bool, opts domain.NameSearchOptions) ([]domain.SimilarNameResult, error) {
+	klakiniOK, err := klakiniFilter(day, allowKlakini)
+	if err != nil {
+		return nil, err
+	}
+	sorted := bySearchSort(opts)
+	return c.search(name, limit, offset, searchOptions{
+		rank: opts.Rank,
+		keep: func(row *domain.SimilarNameResult) bool {
+			return klakiniOK(row) && opts.Matches(row)
+		},
+		threshold: similarNamesThreshold,
+		less: func(a, b scoredName) bool {
+			if exactA, exactB := a.row.ThName == name, b.row.ThName == name; exactA != exactB {
+				return exactA
+			}
+			return sorted(a, b)
+		},
+	})
+}
+
+// GetBestSimilarNames returns similar names whose pairs are all of a top-tier type.
+func (c *NamesMiracleIndex) GetBestSimilarNames(name, day string, limit int, allowKlakini bool) ([]domain.SimilarNameResult, error) {
+	klakiniOK, err := klakiniFilter(day, allowKlakini)
+	if err != nil {
+		return nil, err
+	}
+	rs := c.rulesets.Active()
+	return c.search(name, limit, 0, searchOptions{
+		rank: domain.RankSpelling,
+		keep: func(row *domain.SimilarNameResult) bool {
+			return klakiniOK(row) && allPairTypes(row, rs.IsTopTierType)
+		},
+		threshold: bestNamesThreshold,
+		less:      bySimilarity,
+	})
+}
+
+// GetAuspiciousNames ranks every name, those starting with preferredConsonant
+// (after an optional leading vowel) first, then by similarity.
+func (c *NamesMiracleIndex) GetAuspiciousNames(name, preferredConsonant, day string, limit, offset int, allowKlakini, findGoodOnly bool, opts domain.NameSearchOptions) ([]domain.SimilarNameResult, error) {
+	klakiniOK, err := klakiniFilter(day, allowKlakini)
+	if err != nil {
+		return nil, err
+	}
+	rs := c.rulesets.Active()
+	notBad := func(t string) bool { return !rs.IsBad(t) }
+	sorted := bySearchSort(opts)
+	return c.search(name, limit, offset, searchOptions{
+		rank: opts.Rank,
+		keep: func(row *domain.SimilarNameResult) bool {
+			return klakiniOK(row) && opts.Matches(row) && (!findGoodOnly || allPairTypes(row, notBad))
+		},
+		threshold:  -1, // Every name qualifies
+		includeAll: true,
+		less: func(a, b scoredName) bool {
+			if preferredConsonant != "" {
+				if pa, pb := startsWithConsonant(a.row.ThName, preferredConsonant), startsWithConsonant(b.row.ThName, preferredConsonant); pa != pb {
+					return pa
+				}
+			}
+			return sorted(a, b)
+		},
+	})
+}
+
+// GetFallbackNames walks names alphabetically, which pg does well; it stays in SQL.
+func (c *NamesMiracleIndex) GetFallbackNames(name, preferredConsonant, day string, limit int, allowKlakini bool, excludedIDs []int) ([]domain.SimilarNameResult, error) {
+	return c.repo.GetFallbackNames(name, preferredConsonant, day, limit, allowKlakini, excludedIDs)
+}
+
+func (c *NamesMiracleIndex) Create(name *domain.SimilarNameResult) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if err := c.repo.Create(name); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.loaded {
+		i := len(c.entries)
+		e := &namesMiracleEntry{row: storedColumns(name)}
+		e.index(c.engine, c.postings, c.soundPostings, i)
+		c.entries = append(c.entries, e)
+		c.byID[name.NameID] = i
+	}
+	return nil
+}
+
+func (c *NamesMiracleIndex) Delete(id int) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if err := c.repo.Delete(id); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Postings keep pointing at the entry until the next Reload; searches skip it.
+	if i, ok := c.byID[id]; ok {
+		c.entries[i].deleted = true
+		delete(c.byID, id)
+	}
+	return nil
+}
+
+func (c *NamesMiracleIndex) UpdateComputed(name *domain.SimilarNameResult) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if err := c.repo.UpdateComputed(name); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// The name itself does not change, so its spelling postings stay valid; a new
+	// phonetic key moves the entry between the sound postings.
+	if i, ok := c.byID[name.NameID]; ok {
+		e := c.entries[i]
+		row := storedColumns(name)
+		row.ThName = e.row.ThName
+		if row.PhoneticKey == "" {
+			row.PhoneticKey = e.row.PhoneticKey
+		}
+		if row.PhoneticKey != e.row.PhoneticKey {
+			removePostings(c.soundPostings, numerology.PhoneticSimilarityKeys(e.row.PhoneticKey), i)
+			e.soundKeys = addPostings(c.soundPostings, numerology.PhoneticSimilarityKeys(row.PhoneticKey), i)
+		}
+		e.row = row
+	}
+	return nil
+}
+
+func (c *NamesMiracleIndex) GetLatest(limit int) ([]domain.SimilarNameResult, error) {
+	return c.repo.GetLatest(limit)
+}
+
+func (c *NamesMiracleIndex) Count() (int, error) {
+	return c.repo.Count()
+}
+
+func (c *NamesMiracleIndex) GetBatch(afterID, limit int) ([]domain.SimilarNameResult, error) {
+	return c.repo.GetBatch(afterID, limit)
+}
+
+// storedColumns keeps only what names_miracle stores, so the index holds the
+// same rows it would read back from the table.
+func storedColumns(name *domain.SimilarNameResult) domain.SimilarNameResult {
+	return domain.SimilarNameResult{
+		NameID: name.NameID, ThName: name.ThName, PhoneticKey: name.PhoneticKey, SatNum: name.SatNum, ShaNum: name.ShaNum, TSat: name.TSat, TSha: name.TSha,
+		KSunday: name.KSunday, KMonday: name.KMonday, KTuesday: name.KTuesday, KWednesday1: name.KWednesday1,
+		KWednesday2: name.KWednesday2, KThursday: name.KThursday, KFriday: name.KFriday, KSaturday: name.KSaturday,
+		Gender: name.Gender, Syllables: name.Syllables, FirstLetter: name.FirstLetter, CategoryStrengths: name.CategoryStrengths,
+	}
+}
+
+// --- Search ---
+
+type scoredName struct {
+	row        *domain.SimilarNameResult
+	similarity float64
+}
+
+type searchOptions struct {
+	rank       string // domain.RankSpelling, RankPhonetic or RankBlend
+	keep       func(row *domain.SimilarNameResult) bool
+	threshold  float64 // Names must score above this
+	includeAll bool    // Rank names that share no key too
+	less       func(a, b scoredName) bool
+}
+
+// search ranks the kept names against name and returns the page [offset, offset+limit)
+// in the columns the SQL queries returned.
+func (c *NamesMiracleIndex) search(name string, limit, offset int, opts searchOptions) ([]domain.SimilarNameResult, error) {
+	if err := c.EnsureLoaded(); err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		return nil, nil
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	// Count the keys each name shares with the searched name, on spelling,
+	// sound or both depending on the ranking.
+	var keys, soundKeys []string
+	if opts.rank != domain.RankPhonetic {
+		keys = numerology.SimilarityKeys(name)
+	}
+	if opts.rank == domain.RankPhonetic || opts.rank == domain.RankBlend {
+		soundKeys = numerology.PhoneticSimilarityKeys(numerology.PhoneticKey(name))
+	}
+	shared := make(map[int]int)
+	soundShared := make(map[int]int)
+	for _, k := range keys {
+		for _, i := range c.postings[k] {
+			shared[i]++
+		}
+	}
+	for _, k := range soundKeys {
+		for _, i := range c.soundPostings[k] {
+			soundShared[i]++
+		}
+	}
+
+	top := &topNames{less: opts.less, size: offset + limit}
+	consider := func(i int) {
+		e := c.entries[i]
+		if e.deleted || !opts.keep(&e.row) {
+			return
+		}
+		sim := domain.BlendSimilarity(opts.rank,
+			numerology.KeySimilarity(shared[i], len(keys), e.keys),
+			numerology.KeySimilarity(soundShared[i], len(soundKeys), e.soundKeys))
+		if sim <= opts.threshold {
+			return
+		}
+		top.offer(scoredName{row: &e.row, similarity: sim})
+	}
+	if opts.includeAll {
+		for i := range c.entries {
+			consider(i)
+		}
+	} else {
+		for i := range shared {
+			consider(i)
+		}
+		for i := range soundShared {
+			if _, seen := shared[i]; !seen {
+				consider(i)
+			}
+		}
+	}
+
+	ranked := top.sorted()
+	if offset >= len(ranked) {
+		return nil, nil
+	}
+	ranked = ranked[offset:]
+	results := make([]domain.SimilarNameResult, len(ranked))
+	for i, s := range ranked {
+		results[i] = domain.SimilarNameResult{
+			NameID:     s.row.NameID,
+			ThName:     s.row.ThName,
+			SatNum:     s.row.SatNum,
+			ShaNum:     s.row.ShaNum,
+			Similarity: s.similarity,
+		}
+	}
+	return results, nil
+}
+
+func bySimilarity(a, b scoredName) bool {
+	if a.similarity != b.similarity {
+		return a.similarity > b.similarity
+	}
+	if a.row.ThName != b.row.ThName {
+		return a.row.ThName < b.row.ThName
+	}
+	return a.row.NameID < b.row.NameID
+}
+
+// bySearchSort orders by the sort of opts, then by similarity.
+func bySearchSort(opts domain.NameSearchOptions) func(a, b scoredName) bool {
+	switch opts.Sort {
+	case domain.SortCategory:
+		return func(a, b scoredName) bool {
+			if sa, sb := a.row.CategoryStrengths[opts.Category], b.row.CategoryStrengths[opts.Category]; sa != sb {
+				return sa > sb
+			}
+			return bySimilarity(a, b)
+		}
+	case domain.SortSyllables:
+		return func(a, b scoredName) bool {
+			if a.row.Syllables != b.row.Syllables {
+				return a.row.Syllables < b.row.Syllables
+			}
+			return bySimilarity(a, b)
+		}
+	}
+	return bySimilarity
+}
+
+// topNames keeps the best size names seen, as a heap with the worst on top.
+type topNames struct {
+	items []scoredName
+	less  func(a, b scoredName) bool
+	size  int
+}
+
+func (t *topNames) Len() int           { return len(t.items) }
+func (t *topNames) Less(i, j int) bool { return t.less(t.items[j], t.items[i]) }
+func (t *topNames) Swap(i, j int)      { t.items[i], t.items[j] = t.items[j], t.items[i] }
+func (t *topNames) Push(x interface{}) { t.items = append(t.items, x.(scoredName)) }
+func (t *topNames) Pop() interface{} {
+	last := t.items[len(t.items)-1]
+	t.items = t.items[:len(t.items)-1]
+	return last
+}
+
+func (t *topNames) offer(s scoredName) {
+	if len(t.items) < t.size {
+		heap.Push(t, s)
+		return
+	}
+	if t.less(s, t.items[0]) {
+		t.items[0] = s
+		heap.Fix(t, 0)
+	}
+}
+
+// sorted empties the heap, best first.
+func (t *topNames) sorted() []scoredName {
+	out := make([]scoredName, len(t.items))
+	for i := len(out) - 1; i >= 0; i-- {
+		out[i] = heap.Pop(t).(scoredName)
+	}
+	return out
+}
+
+// --- Filters ---
+
+// klakiniFilter keeps names without klakini for day, or every name when allowed.
+func klakiniFilter(day string, allowKlakini bool) (func(row *domain.SimilarNameResult) bool, error) {
+	flag, ok := klakiniFlags[strings.ToLower(day)]
+	if !ok {
+		return nil, fmt.Errorf("invalid day: %s", day)
+	}
+	if allowKlakini {
+		return func(*domain.SimilarNameResult) bool { return true }, nil
+	}
+	return func(row *domain.SimilarNameResult) bool { return !flag(row) }, nil
+}
+
+// klakiniFlags reads the k_* column of each day.
+var klakiniFlags = map[string]func(row *domain.SimilarNameResult) bool{
+	"sunday":     func(r *domain.SimilarNameResult) bool { return r.KSunday },
+	"monday":     func(r *domain.SimilarNameResult) bool { return r.KMonday },
+	"tuesday":    func(r *domain.SimilarNameResult) bool { return r.KTuesday },
+	"wednesday1": func(r *domain.SimilarNameResult) bool { return r.KWednesday1 },
+	"wednesday2": func(r *domain.SimilarNameResult) bool { return r.KWednesday2 },
+	"thursday":   func(r *domain.SimilarNameResult) bool { return r.KThursday },
+	"friday":     func(r *domain.SimilarNameResult) bool { return r.KFriday },
+	"saturday":   func(r *domain.SimilarNameResult) bool { return r.KSaturday },
+}
+
+func allPairTypes(row *domain.SimilarNameResult, ok func(pairType string) bool) bool {
+	for _, types := range [][]domain.PairTypeInfo{row.TSat, row.TSha} {
+		for _, t := range types {
+			if !ok(t.Type) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// startsWithConsonant matches the LIKE patterns of the SQL search: the consonant
+// first, or after one leading vowel.
+func startsWithConsonant(name, consonant string) bool {
+	if strings.HasPrefix(name, consonant) {
+		return true
+	}
+	for _, v := range []string{"เ", "แ", "โ", "ใ", "ไ"} {
+		if strings.HasPrefix(name, v+consonant) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"numberniceic/internal/core/numerology"
 	"numberniceic/internal/core/ports"
 	"sync"
 )
@@ -13,7 +14,7 @@ type NumberCategoryCache struct {
 	numberTypeCache map[string]string
 	// keywordsCache maps a pair number to its keywords
 	keywordsCache map[string][]string
-	once          sync.Once
+	loader        loader
 	mu            sync.RWMutex
 }
 
@@ -26,35 +27,62 @@ func NewNumberCategoryCache(repository ports.NumberCategoryRepository) *NumberCa
 	}
 }
 
-func (c *NumberCategoryCache) loadCache() error {
-	var err error
-	c.once.Do(func() {
-		categories, loadErr := c.repository.GetAll()
-		if loadErr != nil {
-			err = loadErr
-			return
+// build reads the table and returns the commit that swaps the new data in.
+func (c *NumberCategoryCache) build() (func(), error) {
+	categories, err := c.repository.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	cache := make(map[string][]string)
+	numberTypeCache := make(map[string]string)
+	keywordsCache := make(map[string][]string)
+	for _, cat := range categories {
+		cache[cat.PairNumber] = append(cache[cat.PairNumber], cat.Category)
+		// Store number_type (only once per pair number)
+		if _, exists := numberTypeCache[cat.PairNumber]; !exists {
+			numberTypeCache[cat.PairNumber] = cat.NumberType
 		}
+		// Store keywords (only once per pair number)
+		if _, exists := keywordsCache[cat.PairNumber]; !exists {
+			keywordsCache[cat.PairNumber] = cat.Keywords
+		}
+	}
 
+	return func() {
 		c.mu.Lock()
-		defer c.mu.Unlock()
-
-		for _, cat := range categories {
-			c.cache[cat.PairNumber] = append(c.cache[cat.PairNumber], cat.Category)
-			// Store number_type (only once per pair number)
-			if _, exists := c.numberTypeCache[cat.PairNumber]; !exists {
-				c.numberTypeCache[cat.PairNumber] = cat.NumberType
-			}
-			// Store keywords (only once per pair number)
-			if _, exists := c.keywordsCache[cat.PairNumber]; !exists {
-				c.keywordsCache[cat.PairNumber] = cat.Keywords
-			}
-		}
-	})
-	return err
+		c.cache, c.numberTypeCache, c.keywordsCache = cache, numberTypeCache, keywordsCache
+		c.mu.Unlock()
+	}, nil
+}
+
+func (c *NumberCategoryCache) loadCache() error {
+	return c.loader.swap(c.build)
+}
+
+func (c *NumberCategoryCache) stage() (func(), error) {
+	return c.loader.stage(c.build)
+}
+
+func (c *NumberCategoryCache) shareSwap(lock *sync.RWMutex) {
+	c.loader.share(lock)
+}
+
+// Snapshot returns the categories loaded now, fixed for one analysis. Before
+// the first load it returns the cache itself.
+func (c *NumberCategoryCache) Snapshot() numerology.CategoryProvider {
+	if !c.loader.loaded.Load() {
+		return c
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s := &NumberCategoryCache{cache: c.cache, numberTypeCache: c.numberTypeCache, keywordsCache: c.keywordsCache}
+	s.loader.loaded.Store(true)
+	return s
 }
 
 func (c *NumberCategoryCache) GetCategories(pairNumber string) ([]string, bool) {
-	if err := c.loadCache(); err != nil {
+	if err := c.EnsureLoaded(); err != nil {
 		return nil, false
 	}
 
@@ -66,7 +94,7 @@ func (c *NumberCategoryCache) GetCategories(pairNumber string) ([]string, bool)
 }
 
 func (c *NumberCategoryCache) GetNumberType(pairNumber string) string {
-	if err := c.loadCache(); err != nil {
+	if err := c.EnsureLoaded(); err != nil {
 		return ""
 	}
 
@@ -77,7 +105,7 @@ func (c *NumberCategoryCache) GetNumberType(pairNumber string) string {
 }
 
 func (c *NumberCategoryCache) GetKeywords(pairNumber string) []string {
-	if err := c.loadCache(); err != nil {
+	if err := c.EnsureLoaded(); err != nil {
 		return nil
 	}
 
@@ -88,5 +116,10 @@ func (c *NumberCategoryCache) GetKeywords(pairNumber string) []string {
 }
 
 func (c *NumberCategoryCache) EnsureLoaded() error {
-	return c.loadCache()
+	return c.loader.ensure(c.loadCache)
+}
+
+// Reload reads the number categories again.
+func (c *NumberCategoryCache) Reload() error {
+	return c.loader.reload(c.loadCache)
 }
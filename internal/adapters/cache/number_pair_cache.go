@@ -2,6 +2,7 @@ package cache
 
 import (
 	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/numerology"
 	"numberniceic/internal/core/ports"
 	"sync"
 )
@@ -9,9 +10,9 @@ import (
 type NumberPairCache struct {
 	repository ports.NumberPairRepository
 	// cache maps a pair number (e.g., "12") to its meaning.
-	cache map[string]domain.NumberPairMeaning
-	once  sync.Once
-	mu    sync.RWMutex
+	cache  map[string]domain.NumberPairMeaning
+	loader loader
+	mu     sync.RWMutex
 }
 
 func NewNumberPairCache(repository ports.NumberPairRepository) *NumberPairCache {
@@ -21,28 +22,53 @@ func NewNumberPairCache(repository ports.NumberPairRepository) *NumberPairCache
 	}
 }
 
-func (c *NumberPairCache) loadCache() error {
-	var err error
-	c.once.Do(func() {
-		meanings, loadErr := c.repository.GetAll()
-		if loadErr != nil {
-			err = loadErr
-			return
-		}
+// build reads the table and returns the commit that swaps the new data in.
+func (c *NumberPairCache) build() (func(), error) {
+	meanings, err := c.repository.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	cache := make(map[string]domain.NumberPairMeaning, len(meanings))
+	for _, m := range meanings {
+		cache[m.PairNumber] = m
+	}
 
+	return func() {
 		c.mu.Lock()
-		defer c.mu.Unlock()
+		c.cache = cache
+		c.mu.Unlock()
+	}, nil
+}
+
+func (c *NumberPairCache) loadCache() error {
+	return c.loader.swap(c.build)
+}
 
-		for _, m := range meanings {
-			c.cache[m.PairNumber] = m
-		}
-	})
-	return err
+func (c *NumberPairCache) stage() (func(), error) {
+	return c.loader.stage(c.build)
+}
+
+func (c *NumberPairCache) shareSwap(lock *sync.RWMutex) {
+	c.loader.share(lock)
+}
+
+// Snapshot returns the pair meanings loaded now, fixed for one analysis. Before
+// the first load it returns the cache itself.
+func (c *NumberPairCache) Snapshot() numerology.PairProvider {
+	if !c.loader.loaded.Load() {
+		return c
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s := &NumberPairCache{cache: c.cache}
+	s.loader.loaded.Store(true)
+	return s
 }
 
 // GetMeaning retrieves the meaning for a given pair number.
 func (c *NumberPairCache) GetMeaning(pairNumber string) (domain.NumberPairMeaning, bool) {
-	if err := c.loadCache(); err != nil {
+	if err := c.EnsureLoaded(); err != nil {
 		return domain.NumberPairMeaning{}, false
 	}
 
@@ -55,7 +81,7 @@ func (c *NumberPairCache) GetMeaning(pairNumber string) (domain.NumberPairMeanin
 
 // GetAllMeanings returns a copy of the entire cache map.
 func (c *NumberPairCache) GetAllMeanings() (map[string]domain.NumberPairMeaning, error) {
-	if err := c.loadCache(); err != nil {
+	if err := c.EnsureLoaded(); err != nil {
 		return nil, err
 	}
 
@@ -72,5 +98,10 @@ func (c *NumberPairCache) GetAllMeanings() (map[string]domain.NumberPairMeaning,
 
 // EnsureLoaded pre-warms the cache.
 func (c *NumberPairCache) EnsureLoaded() error {
-	return c.loadCache()
+	return c.loader.ensure(c.loadCache)
+}
+
+// Reload reads the pair meanings again.
+func (c *NumberPairCache) Reload() error {
+	return c.loader.reload(c.loadCache)
 }
@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"numberniceic/internal/core/numerology"
 	"numberniceic/internal/core/ports"
 	"sync"
 )
@@ -10,7 +11,7 @@ import (
 type NumerologyCache struct {
 	repository ports.NumerologyRepository
 	cache      map[string]int
-	once       sync.Once
+	loader     loader
 	mu         sync.RWMutex
 }
 
@@ -21,28 +22,63 @@ func NewNumerologyCache(repository ports.NumerologyRepository) *NumerologyCache
 	}
 }
 
-// loadCache fetches data from the repository and populates the in-memory cache.
-func (c *NumerologyCache) loadCache() error {
-	var err error
-	c.once.Do(func() {
-		numerologies, loadErr := c.repository.GetAll()
-		if loadErr != nil {
-			err = loadErr
-			return
-		}
+// build reads the table and returns the commit that swaps the new data in.
+func (c *NumerologyCache) build() (func(), error) {
+	numerologies, err := c.repository.GetAll()
+	if err != nil {
+		return nil, err
+	}
 
+	cache := make(map[string]int, len(numerologies))
+	for _, n := range numerologies {
+		cache[n.Character] = n.Value
+	}
+
+	return func() {
 		c.mu.Lock()
-		defer c.mu.Unlock()
-		for _, n := range numerologies {
-			c.cache[n.Character] = n.Value
-		}
-	})
-	return err
+		c.cache = cache
+		c.mu.Unlock()
+	}, nil
+}
+
+func (c *NumerologyCache) loadCache() error {
+	return c.loader.swap(c.build)
+}
+
+func (c *NumerologyCache) stage() (func(), error) {
+	return c.loader.stage(c.build)
+}
+
+func (c *NumerologyCache) shareSwap(lock *sync.RWMutex) {
+	c.loader.share(lock)
+}
+
+// Snapshot returns the values loaded now, fixed for one analysis. Before
+// the first load it returns the cache itself.
+func (c *NumerologyCache) Snapshot() numerology.ValueProvider {
+	if !c.loader.loaded.Load() {
+		return c
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s := &NumerologyCache{cache: c.cache}
+	s.loader.loaded.Store(true)
+	return s
+}
+
+// EnsureLoaded pre-warms the cache.
+func (c *NumerologyCache) EnsureLoaded() error {
+	return c.loader.ensure(c.loadCache)
+}
+
+// Reload reads the table again, e.g. after an admin edited letter values.
+func (c *NumerologyCache) Reload() error {
+	return c.loader.reload(c.loadCache)
 }
 
 // GetValue retrieves a single numerology value for a given character from the cache.
 func (c *NumerologyCache) GetValue(character string) (int, bool) {
-	if err := c.loadCache(); err != nil {
+	if err := c.EnsureLoaded(); err != nil {
 		return 0, false
 	}
 
@@ -54,7 +90,7 @@ func (c *NumerologyCache) GetValue(character string) (int, bool) {
 
 // GetAll returns a copy of all cached numerology data.
 func (c *NumerologyCache) GetAll() (map[string]int, error) {
-	if err := c.loadCache(); err != nil {
+	if err := c.EnsureLoaded(); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,207 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Reloadable is a cache that can read its data again.
+type Reloadable interface {
+	Reload() error
+}
+
+// stager is a cache whose reload can be built first and committed later, so one
+// reload swaps all of its caches at once.
+type stager interface {
+	stage() (func(), error)
+	shareSwap(lock *sync.RWMutex)
+}
+
+// CacheInfo describes a registered cache and the tables it is built from.
+type CacheInfo struct {
+	Name   string   `json:"name"`
+	Tables []string `json:"tables"`
+}
+
+// ReloadResult reports the reload of one cache.
+type ReloadResult struct {
+	Name     string `json:"name"`
+	Duration string `json:"duration"`
+	Error    string `json:"error,omitempty"`
+}
+
+// AllTables, as a table name, stands for every table of every registered cache.
+const AllTables = "*"
+
+// Registry names the reloadable caches and the tables each one reads, so a
+// change to a table reloads exactly the caches built from it.
+type Registry struct {
+	mu       sync.Mutex   // Serializes reloads across callers
+	swap     sync.RWMutex // Held while a reload commits the caches it rebuilt
+	entries  []registryEntry
+	onReload []func()
+}
+
+type registryEntry struct {
+	CacheInfo
+	cache Reloadable
+}
+
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register adds a cache. Caches without tables are only reloaded by name or by
+// a reload of everything.
+func (r *Registry) Register(name string, c Reloadable, tables ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if s, ok := c.(stager); ok {
+		s.shareSwap(&r.swap)
+	}
+	r.entries = append(r.entries, registryEntry{CacheInfo: CacheInfo{Name: name, Tables: tables}, cache: c})
+}
+
+// OnReload adds fn to run after every reload, while the new data is swapped in,
+// e.g. to drop results computed from the old data.
+func (r *Registry) OnReload(fn func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.onReload = append(r.onReload, fn)
+}
+
+// SnapshotLock returns the lock an engine read-locks while it takes its snapshot
+// of the caches; a reload holds it while it swaps the caches it rebuilt.
+func (r *Registry) SnapshotLock() sync.Locker {
+	return r.swap.RLocker()
+}
+
+// Caches lists the registered caches in registration order.
+func (r *Registry) Caches() []CacheInfo {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	infos := make([]CacheInfo, len(r.entries))
+	for i, e := range r.entries {
+		infos[i] = e.CacheInfo
+	}
+	return infos
+}
+
+// Reload reloads the named caches, or every cache when no name is given.
+func (r *Registry) Reload(names ...string) ([]ReloadResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(names) == 0 {
+		return r.reload(func(registryEntry) bool { return true }), nil
+	}
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[name] = true
+	}
+	for _, e := range r.entries {
+		delete(wanted, e.Name)
+	}
+	for name := range wanted {
+		return nil, fmt.Errorf("unknown cache %q", name)
+	}
+	return r.reload(func(e registryEntry) bool { return contains(names, e.Name) }), nil
+}
+
+// ReloadTables reloads the caches built from any of tables; AllTables matches
+// every cache that has tables.
+func (r *Registry) ReloadTables(tables ...string) []ReloadResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	all := contains(tables, AllTables)
+	return r.reload(func(e registryEntry) bool {
+		for _, t := range e.Tables {
+			if all || contains(tables, t) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Refresh reloads the caches built from any of tables, as ReloadTables does, and
+// joins their errors.
+func (r *Registry) Refresh(tables ...string) error {
+	var errs []error
+	for _, res := range r.ReloadTables(tables...) {
+		if res.Error != "" {
+			errs = append(errs, fmt.Errorf("%s: %s", res.Name, res.Error))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Tables returns the tables of the named caches, or of every cache when no name is given.
+func (r *Registry) Tables(names ...string) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var tables []string
+	for _, e := range r.entries {
+		if len(names) > 0 && !contains(names, e.Name) {
+			continue
+		}
+		for _, t := range e.Tables {
+			if !contains(tables, t) {
+				tables = append(tables, t)
+			}
+		}
+	}
+	return tables
+}
+
+// reload builds the new data of every matched cache first and swaps it in
+// together, so no reader sees one table reloaded and another not yet. A cache
+// that fails to build keeps its old data.
+func (r *Registry) reload(match func(registryEntry) bool) []ReloadResult {
+	var results []ReloadResult
+	var commits []func()
+	for _, e := range r.entries {
+		if !match(e) {
+			continue
+		}
+		start := time.Now()
+		res := ReloadResult{Name: e.Name}
+		var err error
+		if s, ok := e.cache.(stager); ok {
+			var commit func()
+			if commit, err = s.stage(); err == nil {
+				commits = append(commits, commit)
+			}
+		} else {
+			err = e.cache.Reload()
+		}
+		if err != nil {
+			res.Error = err.Error()
+		}
+		res.Duration = time.Since(start).Round(time.Millisecond).String()
+		results = append(results, res)
+	}
+
+	r.swap.Lock()
+	defer r.swap.Unlock()
+	for _, commit := range commits {
+		commit()
+	}
+	if len(results) > 0 {
+		for _, fn := range r.onReload {
+			fn()
+		}
+	}
+	return results
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+package cache
+
+import (
+	"database/sql"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ReloadChannel is the Postgres NOTIFY channel of reference-data changes. The
+// triggers of migration 028 publish the changed table's name on it.
+const ReloadChannel = "reference_data_changed"
+
+// reloadDebounce collects the notifications of a burst of edits into one reload.
+const reloadDebounce = 500 * time.Millisecond
+
+// ReloadListener keeps the caches of a Registry in step with the database: it
+// LISTENs on ReloadChannel and reloads the caches of every changed table, so all
+// instances pick up an edit without a restart.
+type ReloadListener struct {
+	registry   *Registry
+	db         *sql.DB
+	listener   *pq.Listener
+	instanceID string
+	done       chan struct{}
+}
+
+func NewReloadListener(registry *Registry, db *sql.DB, dsn string) (*ReloadListener, error) {
+	listener := pq.NewListener(dsn, 10*time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("Cache reload listener: %v", err)
+		}
+	})
+	if err := listener.Listen(ReloadChannel); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	l := &ReloadListener{
+		registry:   registry,
+		db:         db,
+		listener:   listener,
+		instanceID: uuid.NewString(),
+		done:       make(chan struct{}),
+	}
+	go l.run()
+	return l, nil
+}
+
+func (l *ReloadListener) run() {
+	pending := make(map[string]bool)
+	var flush <-chan time.Time
+	for {
+		select {
+		case n, ok := <-l.listener.Notify:
+			if !ok {
+				return
+			}
+			switch {
+			case n == nil:
+				// The connection was re-established; changes made meanwhile were missed.
+				pending[AllTables] = true
+			case strings.HasSuffix(n.Extra, "@"+l.instanceID):
+				continue // Published by this instance, which has already reloaded
+			default:
+				table, _, _ := strings.Cut(n.Extra, "@")
+				pending[table] = true
+			}
+			if flush == nil {
+				flush = time.After(reloadDebounce)
+			}
+		case <-flush:
+			tables := make([]string, 0, len(pending))
+			for t := range pending {
+				tables = append(tables, t)
+			}
+			pending, flush = make(map[string]bool), nil
+			for _, res := range l.registry.ReloadTables(tables...) {
+				if res.Error != "" {
+					log.Printf("Cache reload of %s failed, keeping the previous data: %s", res.Name, res.Error)
+				} else {
+					log.Printf("Cache %s reloaded in %s after a change to %v", res.Name, res.Duration, tables)
+				}
+			}
+		case <-time.After(90 * time.Second):
+			go l.listener.Ping()
+		case <-l.done:
+			return
+		}
+	}
+}
+
+// Publish tells the other instances that tables changed. This instance skips its
+// own notifications, so reload locally before publishing.
+func (l *ReloadListener) Publish(tables ...string) error {
+	for _, t := range tables {
+		if err := notify(l.db, t+"@"+l.instanceID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Notify tells every running instance that tables changed, for writers outside
+// the server such as the recompute CLI. Tables without a trigger, like
+// names_miracle, are only reloaded through it.
+func Notify(db *sql.DB, tables ...string) error {
+	for _, t := range tables {
+		if err := notify(db, t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func notify(db *sql.DB, payload string) error {
+	_, err := db.Exec(`SELECT pg_notify($1, $2)`, ReloadChannel, payload)
+	return err
+}
+
+func (l *ReloadListener) Close() error {
+	close(l.done)
+	return l.listener.Close()
+}
@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"log"
+	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/numerology"
+	"numberniceic/internal/core/ports"
+	"sync"
+)
+
+// ScoringRulesetCache keeps the active scoring ruleset in memory. When the table is
+// empty or unreachable it serves the fallback ruleset so scoring never stops.
+type ScoringRulesetCache struct {
+	repo     ports.ScoringRulesetRepository
+	fallback *domain.ScoringRuleset
+	active   *domain.ScoringRuleset
+	loader   loader
+	mu       sync.RWMutex
+}
+
+func NewScoringRulesetCache(repo ports.ScoringRulesetRepository, fallback *domain.ScoringRuleset) *ScoringRulesetCache {
+	return &ScoringRulesetCache{repo: repo, fallback: fallback}
+}
+
+// build reads the table and returns the commit that swaps the new data in.
+func (c *ScoringRulesetCache) build() (func(), error) {
+	active, err := c.repo.GetActive()
+	if err != nil {
+		return nil, err
+	}
+	if active == nil {
+		log.Println("No active scoring ruleset found, using built-in defaults")
+		active = c.fallback
+	}
+
+	return func() {
+		c.mu.Lock()
+		c.active = active
+		c.mu.Unlock()
+	}, nil
+}
+
+func (c *ScoringRulesetCache) loadCache() error {
+	return c.loader.swap(c.build)
+}
+
+func (c *ScoringRulesetCache) stage() (func(), error) {
+	return c.loader.stage(c.build)
+}
+
+func (c *ScoringRulesetCache) shareSwap(lock *sync.RWMutex) {
+	c.loader.share(lock)
+}
+
+// Snapshot returns the ruleset loaded now, fixed for one analysis. Before
+// the first load it returns the cache itself.
+func (c *ScoringRulesetCache) Snapshot() numerology.RulesetProvider {
+	if !c.loader.loaded.Load() {
+		return c
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s := &ScoringRulesetCache{fallback: c.fallback, active: c.active}
+	s.loader.loaded.Store(true)
+	return s
+}
+
+// Reload reads the active ruleset again; on failure the current one stays in use.
+func (c *ScoringRulesetCache) Reload() error {
+	return c.loader.reload(c.loadCache)
+}
+
+func (c *ScoringRulesetCache) EnsureLoaded() error {
+	return c.loader.ensure(c.loadCache)
+}
+
+// Active returns the active ruleset, or the fallback if it could not be loaded.
+func (c *ScoringRulesetCache) Active() *domain.ScoringRuleset {
+	if err := c.EnsureLoaded(); err != nil {
+		log.Printf("Error loading scoring ruleset: %v", err)
+		return c.fallback
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.active
+}
@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"numberniceic/internal/core/numerology"
+	"numberniceic/internal/core/ports"
+	"strings"
+	"sync"
+)
+
+type TaksaCache struct {
+	repository ports.TaksaRepository
+	// cache maps a day (e.g., "monday") to the Taksa group of each letter.
+	cache  map[string]map[rune]string
+	loader loader
+	mu     sync.RWMutex
+}
+
+func NewTaksaCache(repository ports.TaksaRepository) *TaksaCache {
+	return &TaksaCache{
+		repository: repository,
+		cache:      make(map[string]map[rune]string),
+	}
+}
+
+// build reads the table and returns the commit that swaps the new data in.
+func (c *TaksaCache) build() (func(), error) {
+	taksas, err := c.repository.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	cache := make(map[string]map[rune]string)
+	for _, t := range taksas {
+		groups, exists := cache[t.Day]
+		if !exists {
+			groups = make(map[rune]string)
+			cache[t.Day] = groups
+		}
+
+		for _, char := range t.Chars {
+			groups[char] = t.Group
+		}
+	}
+
+	return func() {
+		c.mu.Lock()
+		c.cache = cache
+		c.mu.Unlock()
+	}, nil
+}
+
+func (c *TaksaCache) loadCache() error {
+	return c.loader.swap(c.build)
+}
+
+func (c *TaksaCache) stage() (func(), error) {
+	return c.loader.stage(c.build)
+}
+
+func (c *TaksaCache) shareSwap(lock *sync.RWMutex) {
+	c.loader.share(lock)
+}
+
+// Snapshot returns the Taksa groups loaded now, fixed for one analysis. Before
+// the first load it returns the cache itself.
+func (c *TaksaCache) Snapshot() numerology.TaksaProvider {
+	if !c.loader.loaded.Load() {
+		return c
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s := &TaksaCache{cache: c.cache}
+	s.loader.loaded.Store(true)
+	return s
+}
+
+// TaksaGroup returns the Taksa group char falls into for a given day.
+func (c *TaksaCache) TaksaGroup(day string, char rune) (string, bool) {
+	if err := c.EnsureLoaded(); err != nil {
+		return "", false
+	}
+
+	lookupDay := strings.ToLower(strings.TrimSpace(day))
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	group, ok := c.cache[lookupDay][char]
+	return group, ok
+}
+
+// EnsureLoaded pre-warms the cache.
+func (c *TaksaCache) EnsureLoaded() error {
+	return c.loader.ensure(c.loadCache)
+}
+
+// Reload reads the Taksa groups again.
+func (c *TaksaCache) Reload() error {
+	return c.loader.reload(c.loadCache)
+}
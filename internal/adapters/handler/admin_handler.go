@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"numberniceic/internal/adapters/cache"
@@ -32,10 +33,12 @@ type AdminHandler struct {
 	notificationService    *service.NotificationService
 	memberService          *service.MemberService
 	articleService         *service.ArticleService
+	scoringRulesetService  *service.ScoringRulesetService
+	nameDictionaryService  *service.NameDictionaryService
 }
 
-func NewAdminHandler(service *service.AdminService, sampleCache *cache.SampleNamesCache, store *session.Store, buddhistDayService *service.BuddhistDayService, walletColorService *service.WalletColorService, shippingAddressService *service.ShippingAddressService, mobileConfigService *service.MobileConfigService, notificationService *service.NotificationService, memberService *service.MemberService, articleService *service.ArticleService) *AdminHandler {
-	return &AdminHandler{service: service, sampleCache: sampleCache, store: store, buddhistDayService: buddhistDayService, walletColorService: walletColorService, shippingAddressService: shippingAddressService, mobileConfigService: mobileConfigService, notificationService: notificationService, memberService: memberService, articleService: articleService}
+func NewAdminHandler(service *service.AdminService, sampleCache *cache.SampleNamesCache, store *session.Store, buddhistDayService *service.BuddhistDayService, walletColorService *service.WalletColorService, shippingAddressService *service.ShippingAddressService, mobileConfigService *service.MobileConfigService, notificationService *service.NotificationService, memberService *service.MemberService, articleService *service.ArticleService, scoringRulesetService *service.ScoringRulesetService, nameDictionaryService *service.NameDictionaryService) *AdminHandler {
+	return &AdminHandler{service: service, sampleCache: sampleCache, store: store, buddhistDayService: buddhistDayService, walletColorService: walletColorService, shippingAddressService: shippingAddressService, mobileConfigService: mobileConfigService, notificationService: notificationService, memberService: memberService, articleService: articleService, scoringRulesetService: scoringRulesetService, nameDictionaryService: nameDictionaryService}
 }
 
 // --- Sample Names Management ---
@@ -1737,3 +1740,205 @@ func (h *AdminHandler) SendWalletNotificationFromForm(c *fiber.Ctx) error {
 	sess.Save()
 	return c.Redirect("/admin/send-wallet-notification")
 }
+
+// --- Scoring Ruleset Management ---
+
+func (h *AdminHandler) ShowScoringRulesetsPage(c *fiber.Ctx) error {
+	rulesets, err := h.scoringRulesetService.GetAll()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).SendString("Error loading scoring rulesets")
+	}
+
+	active := h.scoringRulesetService.GetActive()
+	rulesJSON, _ := json.MarshalIndent(active.Rules, "", "  ")
+
+	getLocStr := func(key string) string {
+		v := c.Locals(key)
+		if v == nil || v == "<nil>" {
+			return ""
+		}
+		return fmt.Sprintf("%v", v)
+	}
+
+	return templ_render.Render(c, layout.Main(
+		layout.SEOProps{
+			Title:  "Scoring Rulesets",
+			OGType: "website",
+		},
+		c.Locals("IsLoggedIn").(bool),
+		c.Locals("IsAdmin").(bool),
+		c.Locals("IsVIP").(bool),
+		true,
+		"admin",
+		getLocStr("toast_success"),
+		getLocStr("toast_error"),
+		func() string { s, _ := c.Locals("AvatarURL").(string); return s }(),
+		admin.ScoringRulesets(rulesets, active, string(rulesJSON)),
+	))
+}
+
+func (h *AdminHandler) CreateScoringRuleset(c *fiber.Ctx) error {
+	name := c.FormValue("name")
+	rules := c.FormValue("rules")
+	activate := c.FormValue("activate") == "on"
+
+	sess, _ := h.store.Get(c)
+	ruleset, err := h.scoringRulesetService.CreateVersion(name, rules, activate)
+	if err != nil {
+		sess.Set("toast_error", "Error saving ruleset: "+err.Error())
+		sess.Save()
+		return c.Redirect("/admin/scoring-rulesets")
+	}
+
+	sess.Set("toast_success", fmt.Sprintf("Scoring ruleset version %d saved", ruleset.Version))
+	sess.Save()
+	return c.Redirect("/admin/scoring-rulesets")
+}
+
+func (h *AdminHandler) ActivateScoringRuleset(c *fiber.Ctx) error {
+	version, err := strconv.Atoi(c.Params("version"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString("Invalid version")
+	}
+
+	sess, _ := h.store.Get(c)
+	if err := h.scoringRulesetService.Activate(version); err != nil {
+		sess.Set("toast_error", "Error activating ruleset: "+err.Error())
+		sess.Save()
+		return c.Redirect("/admin/scoring-rulesets")
+	}
+
+	sess.Set("toast_success", fmt.Sprintf("Scoring ruleset version %d is now active", version))
+	sess.Save()
+	return c.Redirect("/admin/scoring-rulesets")
+}
+
+// --- Name Dictionary Management ---
+
+const nameDictionaryPageSize = 200
+
+func (h *AdminHandler) ShowNameDictionaryPage(c *fiber.Ctx) error {
+	query := strings.TrimSpace(c.Query("q"))
+	status := c.Query("status")
+	entries, err := h.nameDictionaryService.Search(query, status, nameDictionaryPageSize)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).SendString("Error loading name dictionary")
+	}
+
+	editing := &domain.NameDictionaryEntry{Kind: domain.DictionaryRoot}
+	if id, err := strconv.Atoi(c.Query("edit")); err == nil {
+		if e, err := h.nameDictionaryService.GetByID(id); err == nil && e != nil {
+			editing = e
+		}
+	}
+
+	getLocStr := func(key string) string {
+		v := c.Locals(key)
+		if v == nil || v == "<nil>" {
+			return ""
+		}
+		return fmt.Sprintf("%v", v)
+	}
+
+	return templ_render.Render(c, layout.Main(
+		layout.SEOProps{
+			Title:  "พจนานุกรมชื่อ",
+			OGType: "website",
+		},
+		c.Locals("IsLoggedIn").(bool),
+		c.Locals("IsAdmin").(bool),
+		c.Locals("IsVIP").(bool),
+		true,
+		"admin",
+		getLocStr("toast_success"),
+		getLocStr("toast_error"),
+		func() string { s, _ := c.Locals("AvatarURL").(string); return s }(),
+		admin.NameDictionary(entries, query, status, editing),
+	))
+}
+
+func (h *AdminHandler) SaveNameDictionaryEntry(c *fiber.Ctx) error {
+	id, _ := strconv.Atoi(c.FormValue("id"))
+	entry := &domain.NameDictionaryEntry{
+		ID:      id,
+		Term:    c.FormValue("term"),
+		Kind:    c.FormValue("kind"),
+		Origin:  c.FormValue("origin"),
+		Meaning: c.FormValue("meaning"),
+	}
+
+	sess, _ := h.store.Get(c)
+	if err := h.nameDictionaryService.Save(entry); err != nil {
+		sess.Set("toast_error", "บันทึกไม่สำเร็จ: "+err.Error())
+		sess.Save()
+		return c.Redirect("/admin/name-dictionary")
+	}
+
+	sess.Set("toast_success", "บันทึก '"+entry.Term+"' สำเร็จ")
+	sess.Save()
+	return c.Redirect("/admin/name-dictionary")
+}
+
+func (h *AdminHandler) ImportNameDictionary(c *fiber.Ctx) error {
+	sess, _ := h.store.Get(c)
+	file, err := c.FormFile("csv_file")
+	if err != nil {
+		sess.Set("toast_error", "กรุณาเลือกไฟล์ CSV")
+		sess.Save()
+		return c.Redirect("/admin/name-dictionary")
+	}
+	f, err := file.Open()
+	if err != nil {
+		sess.Set("toast_error", "ไม่สามารถเปิดไฟล์ได้")
+		sess.Save()
+		return c.Redirect("/admin/name-dictionary")
+	}
+	defer f.Close()
+
+	result, err := h.nameDictionaryService.ImportCSV(f)
+	if err != nil {
+		sess.Set("toast_error", fmt.Sprintf("นำเข้าได้ %d รายการ แล้วหยุดที่: %v", result.Imported, err))
+		sess.Save()
+		return c.Redirect("/admin/name-dictionary")
+	}
+
+	msg := fmt.Sprintf("นำเข้าสำเร็จ: %d รายการ, ผิดพลาด: %d รายการ", result.Imported, len(result.Errors))
+	if len(result.Errors) > 0 {
+		msg += " (" + result.Errors[0] + ")"
+	}
+	sess.Set("toast_success", msg)
+	sess.Save()
+	return c.Redirect("/admin/name-dictionary")
+}
+
+func (h *AdminHandler) ApproveNameDictionaryEntry(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString("Invalid id")
+	}
+
+	sess, _ := h.store.Get(c)
+	if err := h.nameDictionaryService.Approve(id); err != nil {
+		sess.Set("toast_error", "อนุมัติไม่สำเร็จ: "+err.Error())
+	} else {
+		sess.Set("toast_success", "อนุมัติรายการแล้ว")
+	}
+	sess.Save()
+	return c.Redirect("/admin/name-dictionary?status=" + domain.DictionaryStatusPending)
+}
+
+func (h *AdminHandler) DeleteNameDictionaryEntry(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString("Invalid id")
+	}
+
+	sess, _ := h.store.Get(c)
+	if err := h.nameDictionaryService.Delete(id); err != nil {
+		sess.Set("toast_error", "ลบไม่สำเร็จ: "+err.Error())
+	} else {
+		sess.Set("toast_success", "ลบรายการแล้ว")
+	}
+	sess.Save()
+	return c.Redirect("/admin/name-dictionary")
+}
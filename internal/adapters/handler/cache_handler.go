@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"log"
+	"numberniceic/internal/adapters/cache"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CacheHandler lets admins reload the in-memory reference caches after editing
+// their tables by hand.
+type CacheHandler struct {
+	registry *cache.Registry
+	listener *cache.ReloadListener // nil when LISTEN/NOTIFY is unavailable
+	results  *cache.AnalysisCache
+}
+
+func NewCacheHandler(registry *cache.Registry, listener *cache.ReloadListener, results *cache.AnalysisCache) *CacheHandler {
+	return &CacheHandler{registry: registry, listener: listener, results: results}
+}
+
+// ListCaches lists the registered caches and their tables, and the hit and miss
+// counters of the analysis result cache.
+func (h *CacheHandler) ListCaches(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{"caches": h.registry.Caches(), "listening": h.listener != nil, "analysis_results": h.results.Stats()})
+}
+
+// ReloadCaches reloads the caches named in the comma-separated name query (all
+// caches when empty) on this instance, then notifies the other instances.
+func (h *CacheHandler) ReloadCaches(c *fiber.Ctx) error {
+	var names []string
+	for _, name := range strings.Split(c.Query("name"), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	results, err := h.registry.Reload(names...)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	published := false
+	if h.listener != nil {
+		if err := h.listener.Publish(h.registry.Tables(names...)...); err != nil {
+			log.Printf("Cache reload: could not notify other instances: %v", err)
+		} else {
+			published = true
+		}
+	}
+
+	status := fiber.StatusOK
+	for _, res := range results {
+		if res.Error != "" {
+			status = fiber.StatusInternalServerError
+		}
+	}
+	return c.Status(status).JSON(fiber.Map{"results": results, "published": published})
+}
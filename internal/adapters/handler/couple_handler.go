@@ -0,0 +1,215 @@
+package handler
+
+import (
+	"fmt"
+	"numberniceic/internal/adapters/handler/templ_render"
+	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/numerology"
+	"numberniceic/internal/core/service"
+	"numberniceic/views/analysis"
+	"numberniceic/views/pages"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/session"
+)
+
+type CoupleHandler struct {
+	service *service.SavedCoupleService
+	engine  *numerology.Engine
+	store   *session.Store
+}
+
+func NewCoupleHandler(service *service.SavedCoupleService, engine *numerology.Engine, store *session.Store) *CoupleHandler {
+	return &CoupleHandler{
+		service: service,
+		engine:  engine,
+		store:   store,
+	}
+}
+
+// CoupleAPI scores two people together. Each person is given as nameN, surnameN
+// and either dayN or birth_dateN (with optional birth_timeN and day_boundaryN).
+func (h *CoupleHandler) CoupleAPI(c *fiber.Ctx) error {
+	people, err := h.coupleParams(c.Query, defaultBirthDay(c))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(unmappedCharsResponse(err))
+	}
+	result := h.engine.Couple(people[0], people[1])
+	return c.JSON(fiber.Map{
+		"result":          result,
+		"share_url":       analysis.CoupleShareURL(people[0], people[1]),
+		"ruleset_version": h.engine.Ruleset().Version,
+	})
+}
+
+// CouplePage renders the couple form and, when both names are given, the result.
+// The query string fully describes the result so the URL can be shared.
+func (h *CoupleHandler) CouplePage(c *fiber.Ctx) error {
+	props := analysis.CouplePageProps{
+		Layout: layoutProps(c, analysis.LayoutProps{
+			Title:       "วิเคราะห์ชื่อคู่รัก เลขศาสตร์และพลังเงา",
+			Description: "ดูความเข้ากันของชื่อสองคน ผลรวมเลขศาสตร์ คู่เลขไขว้ และกาลกิณีต่อกันตามวันเกิด",
+			Keywords:    "ชื่อคู่รัก, ดูดวงคู่, เลขศาสตร์, พลังเงา, ทักษา",
+			Canonical:   "https://xn--b3cu8e7ah6h.com/couple",
+			ActivePage:  "couple",
+		}),
+		IsLoggedIn: c.Locals("IsLoggedIn") == true,
+	}
+
+	people, err := h.coupleParams(c.Query, defaultBirthDay(c))
+	props.People = people
+	switch {
+	case people[0].Name == "" && people[1].Name == "":
+		// Empty form
+	case err != nil:
+		c.Status(fiber.StatusBadRequest)
+		props.Error = err.Error()
+	default:
+		props.Result = h.engine.Couple(people[0], people[1])
+	}
+
+	return templ_render.Render(c, analysis.CouplePage(props))
+}
+
+// SaveCouple keeps a couple on the member's dashboard. It accepts the same
+// fields as CoupleAPI, as a form or JSON body.
+func (h *CoupleHandler) SaveCouple(c *fiber.Ctx) error {
+	userID, ok := h.userID(c)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "Unauthorized"})
+	}
+
+	value := c.FormValue
+	if strings.HasPrefix(c.Get("Content-Type"), "application/json") {
+		var body map[string]string
+		if err := c.BodyParser(&body); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request"})
+		}
+		value = func(key string, _ ...string) string { return body[key] }
+	}
+
+	people, err := h.coupleParams(value, defaultBirthDay(c))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(unmappedCharsResponse(err))
+	}
+	result := h.engine.Couple(people[0], people[1])
+	if err := h.service.SaveCouple(userID, result, h.engine.Ruleset().Version); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.JSON(fiber.Map{"message": "Couple saved successfully!"})
+}
+
+// GetSavedCouples renders the saved couples of the member for the dashboard.
+func (h *CoupleHandler) GetSavedCouples(c *fiber.Ctx) error {
+	userID, ok := h.userID(c)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "Unauthorized"})
+	}
+	couples, err := h.service.GetSavedCouples(userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).SendString("Error loading saved couples")
+	}
+	displays := h.prepareDisplays(couples)
+
+	if c.Locals("user_id") != nil {
+		return c.JSON(displays)
+	}
+	return templ_render.Render(c, pages.SavedCouplesList(displays))
+}
+
+func (h *CoupleHandler) DeleteSavedCouple(c *fiber.Ctx) error {
+	userID, ok := h.userID(c)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "Unauthorized"})
+	}
+
+	id, _ := strconv.Atoi(c.Params("id"))
+	if err := h.service.DeleteSavedCouple(id, userID); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Could not delete couple"})
+	}
+
+	if c.Locals("user_id") != nil || strings.HasPrefix(c.Get("Content-Type"), "application/json") {
+		return c.JSON(fiber.Map{"message": "Couple deleted successfully"})
+	}
+
+	couples, err := h.service.GetSavedCouples(userID)
+	if err != nil {
+		return c.SendString("")
+	}
+	return templ_render.Render(c, pages.SavedCouplesList(h.prepareDisplays(couples)))
+}
+
+// prepareDisplays rescores saved couples with the active ruleset.
+func (h *CoupleHandler) prepareDisplays(couples []domain.SavedCouple) []domain.SavedCoupleDisplay {
+	displays := make([]domain.SavedCoupleDisplay, len(couples))
+	for i, sc := range couples {
+		displays[i] = domain.SavedCoupleDisplay{
+			SavedCouple:   sc,
+			FirstDayThai:  service.GetThaiDay(sc.First.Day),
+			SecondDayThai: service.GetThaiDay(sc.Second.Day),
+			Result:        h.engine.Couple(sc.First, sc.Second),
+		}
+	}
+	return displays
+}
+
+// userID reads the member from the JWT (mobile) or the session (web).
+func (h *CoupleHandler) userID(c *fiber.Ctx) (int, bool) {
+	if id, ok := c.Locals("user_id").(int); ok {
+		return id, true
+	}
+	sess, _ := h.store.Get(c)
+	id, ok := sess.Get("member_id").(int)
+	return id, ok
+}
+
+// coupleParams reads both people with value, which is c.Query for GET requests
+// and c.FormValue for saves. The returned people are filled in even on error so
+// the form can be shown again.
+func (h *CoupleHandler) coupleParams(value func(key string, defaultValue ...string) string, memberDay string) ([2]domain.CouplePerson, error) {
+	var people [2]domain.CouplePerson
+	var firstErr error
+	for i := range people {
+		n := strconv.Itoa(i + 1)
+		p := domain.CouplePerson{
+			Name:    service.SanitizeInput(value("name" + n)),
+			Surname: service.SanitizeInput(value("surname" + n)),
+			Day:     strings.ToLower(strings.TrimSpace(value("day" + n))),
+		}
+		if date := strings.TrimSpace(value("birth_date" + n)); date != "" {
+			moment, err := domain.ParseBirthMoment(date, value("birth_time"+n), value("day_boundary"+n))
+			if err != nil && firstErr == nil {
+				firstErr = err
+			} else if err == nil {
+				p.Day = moment.Day()
+			}
+		}
+		if p.Day == "" && i == 0 {
+			p.Day = memberDay // The first person is usually the member
+		}
+		if p.Day == "" {
+			p.Day = domain.BirthDayThursday
+		}
+		people[i] = p
+		if !domain.IsBirthDay(p.Day) && firstErr == nil {
+			firstErr = fmt.Errorf("ไม่รู้จักวันเกิดของคนที่ %d: %s", i+1, p.Day)
+		}
+
+		if firstErr != nil {
+			continue
+		}
+		if p.Name == "" {
+			firstErr = fmt.Errorf("กรุณาระบุชื่อคนที่ %d", i+1)
+			continue
+		}
+		if err := h.engine.Validate(p.Name); err != nil {
+			firstErr = err
+		} else if err := h.engine.Validate(p.Surname); err != nil {
+			firstErr = err
+		}
+	}
+	return people, firstErr
+}
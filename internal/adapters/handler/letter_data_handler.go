@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"net/url"
+	"numberniceic/internal/adapters/handler/templ_render"
+	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/numerology"
+	"numberniceic/internal/core/service"
+	"numberniceic/views/pages/admin"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/session"
+)
+
+// LetterDataHandler serves the admin page and JSON API for the letter values
+// (sat_nums, sha_nums) and the klakini letters of each day (kakis_day).
+type LetterDataHandler struct {
+	service *service.LetterDataService
+	store   *session.Store
+}
+
+func NewLetterDataHandler(service *service.LetterDataService, store *session.Store) *LetterDataHandler {
+	return &LetterDataHandler{service: service, store: store}
+}
+
+func (h *LetterDataHandler) ShowLettersPage(c *fiber.Ctx) error {
+	letters, err := h.service.Letters()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).SendString("Error loading letter values")
+	}
+	history, _ := h.service.LetterHistory(numberDataHistoryLimit)
+
+	var editing *domain.LetterValue
+	if char := c.Query("edit"); char != "" {
+		editing, _ = h.service.GetLetter(char)
+	}
+	return renderAdminPage(c, "ค่าตัวอักษรและกาลกิณี", admin.LetterValues(letters, editing, numerology.Days, history))
+}
+
+// letterValue reads an optional value; an empty field means no row.
+func letterValue(s string) *int {
+	v, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return nil
+	}
+	return &v
+}
+
+func letterFromForm(c *fiber.Ctx) *domain.LetterValue {
+	lv := &domain.LetterValue{
+		Char:     c.FormValue("char"),
+		SatValue: letterValue(c.FormValue("sat_value")),
+		ShaValue: letterValue(c.FormValue("sha_value")),
+	}
+	for _, day := range c.Request().PostArgs().PeekMulti("klakini_days") {
+		lv.KlakiniDays = append(lv.KlakiniDays, string(day))
+	}
+	return lv
+}
+
+func (h *LetterDataHandler) SaveLetter(c *fiber.Ctx) error {
+	lv := letterFromForm(c)
+	err := h.service.SaveLetter(lv, changedBy(c))
+	return redirectWithToast(c, h.store, "/admin/letters?edit="+url.QueryEscape(lv.Char)+"#letter-form", err, "บันทึกตัวอักษร "+lv.Char+" สำเร็จ")
+}
+
+// PreviewLetter renders the sample names before and after the form values for htmx.
+func (h *LetterDataHandler) PreviewLetter(c *fiber.Ctx) error {
+	preview, err := h.service.PreviewLetter(letterFromForm(c), c.FormValue("day"))
+	if err != nil {
+		return templ_render.Render(c, admin.LetterPreview(nil, err.Error()))
+	}
+	return templ_render.Render(c, admin.LetterPreview(preview, ""))
+}
+
+// --- JSON API ---
+
+func (h *LetterDataHandler) ListLettersAPI(c *fiber.Ctx) error {
+	letters, err := h.service.Letters()
+	if err != nil {
+		return numberDataError(c, err)
+	}
+	return c.JSON(letters)
+}
+
+// SaveLetterAPI stores the letter in the body, replacing its values and klakini days.
+func (h *LetterDataHandler) SaveLetterAPI(c *fiber.Ctx) error {
+	var lv domain.LetterValue
+	if err := c.BodyParser(&lv); err != nil {
+		return numberDataError(c, errInvalidBody)
+	}
+	if err := h.service.SaveLetter(&lv, changedBy(c)); err != nil {
+		return numberDataError(c, err)
+	}
+	return c.JSON(lv)
+}
+
+// PreviewLetterAPI scores the sample names for ?day= before and after saving the body.
+func (h *LetterDataHandler) PreviewLetterAPI(c *fiber.Ctx) error {
+	var lv domain.LetterValue
+	if err := c.BodyParser(&lv); err != nil {
+		return numberDataError(c, errInvalidBody)
+	}
+	preview, err := h.service.PreviewLetter(&lv, c.Query("day", numerology.Days[0]))
+	if err != nil {
+		return numberDataError(c, err)
+	}
+	return c.JSON(preview)
+}
+
+func (h *LetterDataHandler) HistoryAPI(c *fiber.Ctx) error {
+	history, err := h.service.LetterHistory(c.QueryInt("limit", numberDataHistoryLimit))
+	if err != nil {
+		return numberDataError(c, err)
+	}
+	return c.JSON(history)
+}
@@ -3,17 +3,16 @@ package handler
 import (
 	"fmt"
 	"log"
-	"numberniceic/internal/adapters/cache"
 	"numberniceic/internal/adapters/handler/templ_render"
 	"numberniceic/internal/adapters/repository"
 	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/numerology"
 	"numberniceic/internal/core/service"
 	"numberniceic/views/layout"
 	"numberniceic/views/pages"
 	"strconv"
 	"strings"
 	"time"
-	"unicode"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/session"
@@ -27,20 +26,18 @@ type MemberHandler struct {
 	savedNameService       *service.SavedNameService
 	buddhistDayService     *service.BuddhistDayService
 	shippingAddressService *service.ShippingAddressService
-	klakiniCache           *cache.KlakiniCache
-	numberPairCache        *cache.NumberPairCache
+	engine                 *numerology.Engine
 	store                  *session.Store
 	promotionalCodeRepo    *repository.PostgresPromotionalCodeRepository
 }
 
-func NewMemberHandler(service *service.MemberService, savedNameService *service.SavedNameService, buddhistDayService *service.BuddhistDayService, shippingAddressService *service.ShippingAddressService, klakiniCache *cache.KlakiniCache, numberPairCache *cache.NumberPairCache, store *session.Store, promotionalCodeRepo *repository.PostgresPromotionalCodeRepository) *MemberHandler {
+func NewMemberHandler(service *service.MemberService, savedNameService *service.SavedNameService, buddhistDayService *service.BuddhistDayService, shippingAddressService *service.ShippingAddressService, engine *numerology.Engine, store *session.Store, promotionalCodeRepo *repository.PostgresPromotionalCodeRepository) *MemberHandler {
 	return &MemberHandler{
 		service:                service,
 		savedNameService:       savedNameService,
 		buddhistDayService:     buddhistDayService,
 		shippingAddressService: shippingAddressService,
-		klakiniCache:           klakiniCache,
-		numberPairCache:        numberPairCache,
+		engine:                 engine,
 		store:                  store,
 		promotionalCodeRepo:    promotionalCodeRepo,
 	}
@@ -224,124 +221,13 @@ func (h *MemberHandler) HandleLogout(c *fiber.Ctx) error {
 }
 
 func (h *MemberHandler) prepareDisplayNames(savedNames []domain.SavedName) []domain.SavedNameDisplay {
-	if h.klakiniCache == nil || h.numberPairCache == nil {
-		log.Println("ERROR: Caches are nil in prepareDisplayNames")
+	if h.engine == nil {
+		log.Println("ERROR: Numerology engine is nil in prepareDisplayNames")
 		return nil
 	}
-	displayNames := make([]domain.SavedNameDisplay, len(savedNames))
-	for i, sn := range savedNames {
-		satPairs := h.getPairsWithColors(sn.SatSum)
-		shaPairs := h.getPairsWithColors(sn.ShaSum)
-
-		// Calculate IsTopTier
-		isTopTier := h.isAllPairsTopTier(satPairs) && h.isAllPairsTopTier(shaPairs)
-
-		// Create DisplayNameHTML
-		var displayChars []domain.DisplayChar
-		runes := []rune(sn.Name)
-		for j := 0; j < len(runes); j++ {
-			r := runes[j]
-			char := string(r)
-			isBad := h.klakiniCache.IsKlakini(sn.BirthDay, r)
-
-			// Check if the next character is a combining mark
-			if j+1 < len(runes) && unicode.Is(unicode.Mn, runes[j+1]) {
-				combiningChar := runes[j+1]
-				isCombiningBad := h.klakiniCache.IsKlakini(sn.BirthDay, combiningChar)
-
-				// If the base is not bad, but the combining mark is
-				if !isBad && isCombiningBad {
-					// Add the base character as good
-					displayChars = append(displayChars, domain.DisplayChar{Char: char, IsBad: false})
-					// Add the combining mark as bad
-					displayChars = append(displayChars, domain.DisplayChar{Char: string(combiningChar), IsBad: true})
-					j++ // Skip the combining mark in the next iteration
-					continue
-				}
-			}
-
-			// Default behavior: add the character with its own klakini status
-			displayChars = append(displayChars, domain.DisplayChar{Char: char, IsBad: isBad})
-		}
-
-		displayNames[i] = domain.SavedNameDisplay{
-			SavedName:       sn,
-			BirthDayThai:    service.GetThaiDay(sn.BirthDay),
-			BirthDayRaw:     strings.ToUpper(sn.BirthDay),
-			KlakiniChars:    h.getKlakiniChars(sn.Name, sn.BirthDay),
-			SatPairs:        satPairs,
-			ShaPairs:        shaPairs,
-			DisplayNameHTML: displayChars,
-			IsTopTier:       isTopTier,
-		}
-	}
-	return displayNames
-}
-
-func (h *MemberHandler) isAllPairsTopTier(pairs []domain.PairInfo) bool {
-	if len(pairs) == 0 {
-		return false
-	}
-	for _, p := range pairs {
-		if meaning, ok := h.numberPairCache.GetMeaning(p.Number); ok {
-			if !service.IsGoodPairType(meaning.PairType) {
-				return false
-			}
-		} else {
-			return false
-		}
-	}
-	return true
+	return prepareSavedNameDisplays(h.engine, savedNames)
 }
 
-func (h *MemberHandler) getKlakiniChars(name, day string) []string {
-	var klakiniChars []string
-	for _, r := range name {
-		if h.klakiniCache.IsKlakini(day, r) {
-			klakiniChars = append(klakiniChars, string(r))
-		}
-	}
-	return klakiniChars
-}
-
-func (h *MemberHandler) getPairsWithColors(sum int) []domain.PairInfo {
-	if h.numberPairCache == nil {
-		log.Println("ERROR: numberPairCache is nil in getPairsWithColors")
-		return nil
-	}
-	s := strconv.Itoa(sum)
-	var pairs []string
-	if sum < 0 {
-		// No pairs for negative sums
-	} else if len(s) < 2 {
-		pairs = append(pairs, "0"+s)
-	} else if len(s) == 2 {
-		pairs = append(pairs, s)
-	} else { // len(s) > 2
-		if len(s)%2 != 0 {
-			for i := 0; i < len(s)-1; i++ {
-				pairs = append(pairs, s[i:i+2])
-			}
-		} else {
-			for i := 0; i < len(s); i += 2 {
-				pairs = append(pairs, s[i:i+2])
-			}
-		}
-	}
-
-	var pairInfos []domain.PairInfo
-	for _, p := range pairs {
-		meaning, ok := h.numberPairCache.GetMeaning(p)
-		color := "#ccc" // Default color
-		pairType := ""  // Default type
-		if ok {
-			color = meaning.Color
-			pairType = meaning.PairType
-		}
-		pairInfos = append(pairInfos, domain.PairInfo{Number: p, Color: color, Type: pairType})
-	}
-	return pairInfos
-}
 func (h *MemberHandler) isValidUsername(username string) bool {
 	if len(username) < 3 {
 		return false
@@ -650,6 +536,43 @@ func (h *MemberHandler) HandleUpdateProfileAPI(c *fiber.Ctx) error {
 	})
 }
 
+// HandleUpdateBirthAPI stores the member's birth date, optional time and day
+// boundary, and returns the Thai birth day derived from them.
+func (h *MemberHandler) HandleUpdateBirthAPI(c *fiber.Ctx) error {
+	sess, _ := h.store.Get(c)
+	memberID, ok := sess.Get("member_id").(int)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "กรุณาเข้าสู่ระบบ",
+		})
+	}
+
+	type UpdateBirthRequest struct {
+		BirthDate   string `json:"birth_date" form:"birth_date"`
+		BirthTime   string `json:"birth_time" form:"birth_time"`
+		DayBoundary string `json:"day_boundary" form:"day_boundary"`
+	}
+	var req UpdateBirthRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "รูปแบบข้อมูลไม่ถูกต้อง",
+		})
+	}
+
+	moment, err := h.service.UpdateBirthMoment(memberID, req.BirthDate, req.BirthTime, req.DayBoundary)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"message":      "บันทึกข้อมูลเรียบร้อยแล้ว",
+		"birth_moment": moment,
+		"birth_day":    moment.Day(),
+	})
+}
+
 // --- API Shipping Address Handlers ---
 
 func (h *MemberHandler) GetShippingAddressesAPI(c *fiber.Ctx) error {
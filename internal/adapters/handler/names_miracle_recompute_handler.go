@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"errors"
+	"numberniceic/internal/core/service"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// NamesMiracleRecomputeHandler lets admins run the names_miracle recompute job
+// after sat_nums, sha_nums, klakini or number_pairs have been edited.
+type NamesMiracleRecomputeHandler struct {
+	service *service.NamesMiracleRecomputeService
+}
+
+func NewNamesMiracleRecomputeHandler(service *service.NamesMiracleRecomputeService) *NamesMiracleRecomputeHandler {
+	return &NamesMiracleRecomputeHandler{service: service}
+}
+
+// StartRecompute starts a background run. Query params: dry_run (default true),
+// batch and samples.
+func (h *NamesMiracleRecomputeHandler) StartRecompute(c *fiber.Ctx) error {
+	opts := service.RecomputeOptions{
+		BatchSize: c.QueryInt("batch", service.DefaultRecomputeBatchSize),
+		DryRun:    c.QueryBool("dry_run", true),
+		Samples:   c.QueryInt("samples", service.DefaultRecomputeSamples),
+	}
+	if err := h.service.Start(opts); err != nil {
+		if errors.Is(err, service.ErrRecomputeRunning) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusAccepted).JSON(h.service.Status())
+}
+
+// RecomputeStatus reports the progress of the running job and the last report.
+func (h *NamesMiracleRecomputeHandler) RecomputeStatus(c *fiber.Ctx) error {
+	return c.JSON(h.service.Status())
+}
@@ -0,0 +1,310 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"numberniceic/internal/adapters/handler/templ_render"
+	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/numerology"
+	"numberniceic/internal/core/service"
+	"numberniceic/views/layout"
+	"numberniceic/views/pages/admin"
+	"strconv"
+	"strings"
+
+	"github.com/a-h/templ"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/session"
+)
+
+const numberDataHistoryLimit = 50
+
+// NumberDataHandler serves the admin pages and JSON APIs for the pair meanings
+// (numbers) and their categories.
+type NumberDataHandler struct {
+	service *service.NumberDataService
+	store   *session.Store
+}
+
+func NewNumberDataHandler(service *service.NumberDataService, store *session.Store) *NumberDataHandler {
+	return &NumberDataHandler{service: service, store: store}
+}
+
+// changedBy is the member_id of the admin making the request.
+func changedBy(c *fiber.Ctx) int {
+	id, _ := c.Locals("UserID").(int)
+	return id
+}
+
+// renderAdminPage renders page in the main layout of the admin pages.
+func renderAdminPage(c *fiber.Ctx, title string, page templ.Component) error {
+	getLocStr := func(key string) string {
+		v := c.Locals(key)
+		if v == nil || v == "<nil>" {
+			return ""
+		}
+		return fmt.Sprintf("%v", v)
+	}
+
+	return templ_render.Render(c, layout.Main(
+		layout.SEOProps{
+			Title:  title,
+			OGType: "website",
+		},
+		c.Locals("IsLoggedIn").(bool),
+		c.Locals("IsAdmin").(bool),
+		c.Locals("IsVIP").(bool),
+		true,
+		"admin",
+		getLocStr("toast_success"),
+		getLocStr("toast_error"),
+		func() string { s, _ := c.Locals("AvatarURL").(string); return s }(),
+		page,
+	))
+}
+
+// redirectWithToast redirects to the page of an admin form with its outcome.
+func redirectWithToast(c *fiber.Ctx, store *session.Store, to string, err error, success string) error {
+	sess, _ := store.Get(c)
+	if err != nil {
+		sess.Set("toast_error", "บันทึกไม่สำเร็จ: "+err.Error())
+	} else {
+		sess.Set("toast_success", success)
+	}
+	sess.Save()
+	return c.Redirect(to)
+}
+
+// --- Number Pairs ---
+
+func (h *NumberDataHandler) ShowPairsPage(c *fiber.Ctx) error {
+	pairs, err := h.service.ListPairs()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).SendString("Error loading number pairs")
+	}
+	history, _ := h.service.History(service.NumbersTable, "", numberDataHistoryLimit)
+
+	editing := &domain.NumberPairMeaning{}
+	if pair := c.Query("edit"); pair != "" {
+		if m, err := h.service.GetPair(pair); err == nil && m != nil {
+			editing = m
+		}
+	}
+	return renderAdminPage(c, "ความหมายคู่เลข", admin.NumberPairs(pairs, editing, history))
+}
+
+func pairFromForm(c *fiber.Ctx) *domain.NumberPairMeaning {
+	point, _ := strconv.Atoi(c.FormValue("pair_point"))
+	return &domain.NumberPairMeaning{
+		PairNumber:    c.FormValue("pair_number"),
+		PairType:      c.FormValue("pair_type"),
+		MiracleDetail: c.FormValue("miracle_detail"),
+		MiracleDesc:   c.FormValue("miracle_desc"),
+		PairPoint:     point,
+	}
+}
+
+func (h *NumberDataHandler) SavePair(c *fiber.Ctx) error {
+	m := pairFromForm(c)
+	err := h.service.SavePair(m, changedBy(c))
+	return redirectWithToast(c, h.store, "/admin/number-pairs", err, "บันทึกคู่เลข "+m.PairNumber+" สำเร็จ")
+}
+
+func (h *NumberDataHandler) DeletePair(c *fiber.Ctx) error {
+	pair := c.Params("pair")
+	err := h.service.DeletePair(pair, changedBy(c))
+	return redirectWithToast(c, h.store, "/admin/number-pairs", err, "ลบคู่เลข "+pair+" แล้ว")
+}
+
+// PreviewPair renders the impact of the form values for htmx.
+func (h *NumberDataHandler) PreviewPair(c *fiber.Ctx) error {
+	impact, err := h.service.PreviewPair("", pairFromForm(c))
+	if err != nil {
+		return templ_render.Render(c, admin.NumberDataImpact(nil, err.Error()))
+	}
+	return templ_render.Render(c, admin.NumberDataImpact(impact, ""))
+}
+
+// --- Number Categories ---
+
+func (h *NumberDataHandler) ShowCategoriesPage(c *fiber.Ctx) error {
+	categories, err := h.service.ListCategories()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).SendString("Error loading number categories")
+	}
+	history, _ := h.service.History(service.NumberCategoriesTable, "", numberDataHistoryLimit)
+
+	editing := &domain.NumberCategory{NumberType: domain.NumberTypeGood}
+	if id, err := strconv.Atoi(c.Query("edit")); err == nil {
+		if cat, err := h.service.GetCategory(id); err == nil && cat != nil {
+			editing = cat
+		}
+	}
+	return renderAdminPage(c, "หมวดหมู่คู่เลข", admin.NumberCategories(categories, editing, numerology.Categories, history))
+}
+
+// splitKeywords reads keywords separated by commas or new lines.
+func splitKeywords(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == '\n' })
+}
+
+func (h *NumberDataHandler) SaveCategory(c *fiber.Ctx) error {
+	id, _ := strconv.Atoi(c.FormValue("id"))
+	cat := &domain.NumberCategory{
+		ID:         id,
+		PairNumber: c.FormValue("pair_number"),
+		Category:   c.FormValue("category"),
+		NumberType: c.FormValue("number_type"),
+		Keywords:   splitKeywords(c.FormValue("keywords")),
+	}
+	err := h.service.SaveCategory(cat, changedBy(c))
+	return redirectWithToast(c, h.store, "/admin/number-categories", err, "บันทึกหมวดหมู่ของคู่เลข "+cat.PairNumber+" สำเร็จ")
+}
+
+func (h *NumberDataHandler) DeleteCategory(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString("Invalid id")
+	}
+	err = h.service.DeleteCategory(id, changedBy(c))
+	return redirectWithToast(c, h.store, "/admin/number-categories", err, "ลบหมวดหมู่แล้ว")
+}
+
+func (h *NumberDataHandler) PreviewCategory(c *fiber.Ctx) error {
+	impact, err := h.service.PreviewCategory(c.FormValue("pair_number"))
+	if err != nil {
+		return templ_render.Render(c, admin.NumberDataImpact(nil, err.Error()))
+	}
+	return templ_render.Render(c, admin.NumberDataImpact(impact, ""))
+}
+
+// --- JSON API ---
+
+// numberDataError maps the service errors to 400, 404 or 500.
+func numberDataError(c *fiber.Ctx, err error) error {
+	status := fiber.StatusInternalServerError
+	switch {
+	case errors.Is(err, service.ErrInvalidNumberData):
+		status = fiber.StatusBadRequest
+	case errors.Is(err, service.ErrNumberDataNotFound):
+		status = fiber.StatusNotFound
+	}
+	return c.Status(status).JSON(fiber.Map{"error": err.Error()})
+}
+
+// errInvalidBody is reported when the JSON body or URL cannot be parsed.
+var errInvalidBody = fmt.Errorf("%w: invalid request body", service.ErrInvalidNumberData)
+
+func (h *NumberDataHandler) ListPairsAPI(c *fiber.Ctx) error {
+	pairs, err := h.service.ListPairs()
+	if err != nil {
+		return numberDataError(c, err)
+	}
+	return c.JSON(pairs)
+}
+
+func (h *NumberDataHandler) GetPairAPI(c *fiber.Ctx) error {
+	m, err := h.service.GetPair(c.Params("pair"))
+	if err != nil {
+		return numberDataError(c, err)
+	}
+	if m == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "pair not found"})
+	}
+	return c.JSON(m)
+}
+
+// SavePairAPI creates or replaces the pair in the URL.
+func (h *NumberDataHandler) SavePairAPI(c *fiber.Ctx) error {
+	var m domain.NumberPairMeaning
+	if err := c.BodyParser(&m); err != nil {
+		return numberDataError(c, errInvalidBody)
+	}
+	m.PairNumber = c.Params("pair")
+	if err := h.service.SavePair(&m, changedBy(c)); err != nil {
+		return numberDataError(c, err)
+	}
+	return c.JSON(m)
+}
+
+func (h *NumberDataHandler) DeletePairAPI(c *fiber.Ctx) error {
+	if err := h.service.DeletePair(c.Params("pair"), changedBy(c)); err != nil {
+		return numberDataError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// PreviewPairAPI previews saving the body as the pair in the URL, or deleting
+// it with ?delete=true.
+func (h *NumberDataHandler) PreviewPairAPI(c *fiber.Ctx) error {
+	var proposed *domain.NumberPairMeaning
+	if !c.QueryBool("delete") {
+		proposed = &domain.NumberPairMeaning{}
+		if err := c.BodyParser(proposed); err != nil {
+			return numberDataError(c, errInvalidBody)
+		}
+		proposed.PairNumber = c.Params("pair")
+	}
+	impact, err := h.service.PreviewPair(c.Params("pair"), proposed)
+	if err != nil {
+		return numberDataError(c, err)
+	}
+	return c.JSON(impact)
+}
+
+func (h *NumberDataHandler) ListCategoriesAPI(c *fiber.Ctx) error {
+	categories, err := h.service.ListCategories()
+	if err != nil {
+		return numberDataError(c, err)
+	}
+	return c.JSON(categories)
+}
+
+// SaveCategoryAPI creates a category (POST) or updates the one in the URL (PUT).
+func (h *NumberDataHandler) SaveCategoryAPI(c *fiber.Ctx) error {
+	var cat domain.NumberCategory
+	if err := c.BodyParser(&cat); err != nil {
+		return numberDataError(c, errInvalidBody)
+	}
+	cat.ID = 0
+	if c.Params("id") != "" {
+		id, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return numberDataError(c, errInvalidBody)
+		}
+		cat.ID = id
+	}
+	if err := h.service.SaveCategory(&cat, changedBy(c)); err != nil {
+		return numberDataError(c, err)
+	}
+	return c.JSON(cat)
+}
+
+func (h *NumberDataHandler) DeleteCategoryAPI(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return numberDataError(c, errInvalidBody)
+	}
+	if err := h.service.DeleteCategory(id, changedBy(c)); err != nil {
+		return numberDataError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// PreviewCategoryAPI lists what an edit of the categories of ?pair= touches.
+func (h *NumberDataHandler) PreviewCategoryAPI(c *fiber.Ctx) error {
+	impact, err := h.service.PreviewCategory(c.Query("pair"))
+	if err != nil {
+		return numberDataError(c, err)
+	}
+	return c.JSON(impact)
+}
+
+// HistoryAPI returns changes of ?table= (numbers or number_categories), optionally for ?key=.
+func (h *NumberDataHandler) HistoryAPI(c *fiber.Ctx) error {
+	history, err := h.service.History(c.Query("table", service.NumbersTable), c.Query("key"), c.QueryInt("limit", numberDataHistoryLimit))
+	if err != nil {
+		return numberDataError(c, err)
+	}
+	return c.JSON(history)
+}
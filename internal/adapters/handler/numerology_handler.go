@@ -5,6 +5,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -13,6 +14,7 @@ import (
 	"numberniceic/internal/adapters/cache"
 	"numberniceic/internal/adapters/handler/templ_render"
 	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/numerology"
 	"numberniceic/internal/core/ports"
 	"numberniceic/internal/core/service"
 	"numberniceic/views/analysis"
@@ -20,7 +22,6 @@ import (
 	"strconv"
 	"strings"
 	"time"
-	"unicode"
 
 	"github.com/a-h/templ"
 	"github.com/gofiber/fiber/v2"
@@ -33,39 +34,40 @@ const SearchBatchSize = 200
 // Struct definitions
 
 type NumerologyHandler struct {
-	numerologyCache     *cache.NumerologyCache
-	shadowCache         *cache.NumerologyCache
-	klakiniCache        *cache.KlakiniCache
+	engine              *numerology.Engine
 	numberPairCache     *cache.NumberPairCache
 	numberCategoryCache *cache.NumberCategoryCache
 	namesMiracleRepo    ports.NamesMiracleRepository
 	linguisticService   *service.LinguisticService
 	sampleNamesCache    *cache.SampleNamesCache
 	phoneNumberService  *service.PhoneNumberService
+	results             *cache.AnalysisCache
+	scheduler           *service.SearchScheduler
 	db                  *sql.DB
 }
 
 func NewNumerologyHandler(
-	numCache, shaCache *cache.NumerologyCache,
-	klaCache *cache.KlakiniCache,
+	engine *numerology.Engine,
 	pairCache *cache.NumberPairCache,
 	categoryCache *cache.NumberCategoryCache,
 	namesRepo ports.NamesMiracleRepository,
 	lingoService *service.LinguisticService,
 	sampleCache *cache.SampleNamesCache,
 	phoneNumberService *service.PhoneNumberService,
+	results *cache.AnalysisCache,
+	scheduler *service.SearchScheduler,
 	db *sql.DB,
 ) *NumerologyHandler {
 	return &NumerologyHandler{
-		numerologyCache:     numCache,
-		shadowCache:         shaCache,
-		klakiniCache:        klaCache,
+		engine:              engine,
 		numberPairCache:     pairCache,
 		numberCategoryCache: categoryCache,
 		namesMiracleRepo:    namesRepo,
 		linguisticService:   lingoService,
 		sampleNamesCache:    sampleCache,
 		phoneNumberService:  phoneNumberService,
+		results:             results,
+		scheduler:           scheduler,
 		db:                  db,
 	}
 }
@@ -80,7 +82,21 @@ func (h *NumerologyHandler) AnalyzePhoneNumberAPI(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Number is required"})
 	}
 
-	mainPairs, hiddenPairs, sumMeaning := h.phoneNumberService.AnalyzeRawNumber(number)
+	var mainPairs, hiddenPairs []domain.PhoneNumberPairMeaning
+	var sumMeaning domain.PhoneNumberPairMeaning
+	kind := c.Query("kind")
+	normalized := number
+	if kind == "" {
+		// Without a kind the number is read as typed, as the phone analyzer always has.
+		mainPairs, hiddenPairs, sumMeaning = h.phoneNumberService.AnalyzeRawNumber(number)
+		kind = service.NumberKindPhone
+	} else {
+		var err error
+		normalized, mainPairs, hiddenPairs, sumMeaning, err = h.phoneNumberService.AnalyzeNumberKind(kind, number)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
 
 	// Combine all pairs for category analysis
 	var allPairs []string
@@ -167,36 +183,14 @@ func (h *NumerologyHandler) AnalyzePhoneNumberAPI(c *fiber.Ctx) error {
 		}
 	}
 
-	// Calculate Weighted Score based on User Request
-	// 1. Phone A (Main Pairs) - 55%
-	//    Pair 1: 5%, Pair 2: 5%, Pair 3: 10%, Pair 4: 15%, Pair 5: 20%
-	// 2. Phone B (Hidden Pairs) - 25%
-	//    Pair 1: 3%, Pair 2: 5%, Pair 3: 5%, Pair 4: 12%
-	// 3. Sum - 20%
-	
-	totalScore := 0.0
-
-	// Weights for Main Pairs
-	mainWeights := []float64{0.05, 0.05, 0.10, 0.15, 0.20}
-	for i, pair := range mainPairs {
-		if i < len(mainWeights) {
-			totalScore += float64(pair.Meaning.PairPoint) * mainWeights[i]
-		}
-	}
-
-	// Weights for Hidden Pairs
-	hiddenWeights := []float64{0.03, 0.05, 0.05, 0.12}
-	for i, pair := range hiddenPairs {
-		if i < len(hiddenWeights) {
-			totalScore += float64(pair.Meaning.PairPoint) * hiddenWeights[i]
-		}
-	}
-
-	// Sum Weight
-	totalScore += float64(sumMeaning.Meaning.PairPoint) * 0.20
+	// Each kind weighs its pairs and sum into the total percent
+	profile, _ := service.NumberKind(kind)
+	totalScore := profile.Total(mainPairs, hiddenPairs, sumMeaning)
 
 	return c.JSON(fiber.Map{
 		"number":             number,
+		"kind":               kind,
+		"normalized":         normalized,
 		"main_pairs":         mainPairs,
 		"hidden_pairs":       hiddenPairs,
 		"sum_meaning":        sumMeaning,
@@ -206,18 +200,28 @@ func (h *NumerologyHandler) AnalyzePhoneNumberAPI(c *fiber.Ctx) error {
 }
 
 func (h *NumerologyHandler) AnalyzeAPI(c *fiber.Ctx) error {
-	name := service.SanitizeInput(c.Query("name"))
-	day := strings.ToLower(strings.TrimSpace(c.Query("day")))
-	if day == "" {
-		day = strings.ToLower(strings.TrimSpace(c.Query("birth_day")))
+	if c.Query("mode") == "business" {
+		return h.analyzeBusinessAPI(c)
 	}
-	if day == "" {
-		day = "thursday"
+
+	name := service.SanitizeInput(c.Query("name"))
+	surname := service.SanitizeInput(c.Query("surname"))
+	day, birth, err := birthDayParam(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
 	isAuspicious := c.Query("auspicious") == "true" || c.Query("auspicious") == "on" || c.Query("auspicious") == "1" ||
 		c.Query("is_auspicious") == "true" || c.Query("is_auspicious") == "on" || c.Query("is_auspicious") == "1"
 	disableKlakini := c.Query("disable_klakini") == "true" || c.Query("disable_klakini") == "on" || c.Query("disable_klakini") == "1"
 
+	if err := h.validateName(name, surname); err != nil {
+		resp := unmappedCharsResponse(err)
+		if c.Query("explain") == "true" || c.Query("explain") == "1" {
+			resp["explain"] = h.explain(name, surname)
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(resp)
+	}
+
 	// --- VIP/Admin Detection via Token ---
 	isVIP := false
 	isAdmin := false
@@ -255,6 +259,7 @@ func (h *NumerologyHandler) AnalyzeAPI(c *fiber.Ctx) error {
 	// -------------------------------------
 
 	section := c.Query("section") // "solar" or "names" or empty (all)
+	explain := c.Query("explain") == "true" || c.Query("explain") == "1"
 
 	// 1. Get Solar System Data (Fast)
 	var solarProps interface{}
@@ -265,11 +270,23 @@ func (h *NumerologyHandler) AnalyzeAPI(c *fiber.Ctx) error {
 		}
 		solarProps = props
 		if section == "solar" {
-			return c.JSON(fiber.Map{
-				"solar_system": solarProps,
-				"is_vip":       isVIP,
-				"is_admin":     isAdmin,
-			})
+			resp := fiber.Map{
+				"solar_system":    solarProps,
+				"is_vip":          isVIP,
+				"is_admin":        isAdmin,
+				"ruleset_version": h.engine.Ruleset().Version,
+				"taksa":           h.engine.Taksa(name, day),
+			}
+			if birth != nil {
+				resp["birth"] = birth
+			}
+			if surname != "" {
+				resp["full_name"] = h.engine.AnalyzeFullName(name, surname, day, numerology.Options{AllDays: true})
+			}
+			if explain {
+				resp["explain"] = h.explain(name, surname)
+			}
+			return c.JSON(resp)
 		}
 	}
 
@@ -298,8 +315,14 @@ func (h *NumerologyHandler) AnalyzeAPI(c *fiber.Ctx) error {
 			}
 		}
 
-		var err error
-		similarNames, err = h.fetchSimilarNames(name, day, isAuspicious, repoAllowKlakini, maxLimit_internal, nil)
+		client, vip := searchClient(c)
+		release, err := h.scheduler.Acquire(c.Context(), client, vip, nil)
+		if err != nil {
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": searchQueueMessage(err)})
+		}
+		defer release()
+
+		similarNames, err = h.fetchSimilarNames(name, day, nameSearchParams(c), isAuspicious, repoAllowKlakini, maxLimit_internal, nil)
 		if err == nil {
 			// Cap similarity at 99% if not exact match (Fix for "100%" confusion on similar phonetics)
 			normalizedInput := strings.TrimSpace(name)
@@ -311,6 +334,7 @@ func (h *NumerologyHandler) AnalyzeAPI(c *fiber.Ctx) error {
 			}
 
 			h.calculateScoresAndHighlights(similarNames, day)
+			similarNames = h.rankWithSurname(similarNames, surname, day, isAuspicious)
 		}
 
 		// 1. Prepare Table Candidates (Source of truth)
@@ -324,14 +348,14 @@ func (h *NumerologyHandler) AnalyzeAPI(c *fiber.Ctx) error {
 
 		// 2. Derive Top 4 and Last 4 - FETCH SEPARATELY (Turbo Mode)
 		allowKlakiniTop4 := !disableKlakiniTop4
-		bestCandidates, errBest := h.namesMiracleRepo.GetBestSimilarNames(name, day, 100, allowKlakiniTop4)
+		bestCandidates, errBest := h.bestSimilarNames(name, day, 100, allowKlakiniTop4)
 		if errBest == nil && len(bestCandidates) > 0 {
-			h.calculateScoresAndHighlights(bestCandidates, day)
+			bestCandidates = h.rankWithSurname(bestCandidates, surname, day, true)
 		} else {
 			// Fallback: Use similarNames if Turbo fails or returns nothing
 			bestCandidates = []domain.SimilarNameResult{}
 			for _, n := range similarNames {
-				if !n.HasBadPair {
+				if !n.HasBadPair && (n.Combined == nil || !n.Combined.HasBadPair) {
 					if !allowKlakiniTop4 && len(n.KlakiniChars) > 0 {
 						continue
 					}
@@ -390,6 +414,7 @@ func (h *NumerologyHandler) AnalyzeAPI(c *fiber.Ctx) error {
 					"sha_num":           n.ShaNum,
 					"t_sat":             n.TSat,
 					"t_sha":             n.TSha,
+					"combined":          n.Combined,
 				})
 			}
 			return result
@@ -414,6 +439,8 @@ func (h *NumerologyHandler) AnalyzeAPI(c *fiber.Ctx) error {
 		"top_4_names":          top4,
 		"last_4_names":         last4,
 		"total_filtered_count": totalBest,
+		"ruleset_version":      h.engine.Ruleset().Version,
+		"taksa":                h.engine.Taksa(name, day),
 		"best_names": fiber.Map{
 			"target_name_html":         h.createDisplayChars(name, day),
 			"total_count":              totalBest,
@@ -427,6 +454,15 @@ func (h *NumerologyHandler) AnalyzeAPI(c *fiber.Ctx) error {
 	if section == "" {
 		resp["solar_system"] = solarProps
 	}
+	if birth != nil {
+		resp["birth"] = birth
+	}
+	if surname != "" {
+		resp["full_name"] = h.engine.AnalyzeFullName(name, surname, day, numerology.Options{AllDays: true})
+	}
+	if explain {
+		resp["explain"] = h.explain(name, surname)
+	}
 
 	return c.JSON(resp)
 }
@@ -440,9 +476,33 @@ func (h *NumerologyHandler) GetSampleNamesAPI(c *fiber.Ctx) error {
 	return c.JSON(samples)
 }
 
+// layoutProps completes the page's SEO fields with the session state set by the
+// central middleware, for every page rendered in the analysis layout.
+func layoutProps(c *fiber.Ctx, page analysis.LayoutProps) analysis.LayoutProps {
+	page.OGType = "website"
+	page.IsLoggedIn = c.Locals("IsLoggedIn") == true
+	page.IsAdmin = c.Locals("IsAdmin") == true
+	page.ToastSuccess = c.Locals("toast_success")
+	page.ToastError = c.Locals("toast_error")
+	page.IsVIP = c.Locals("IsVIP") == true
+	page.HasShippingAddress = true // Suppress notification on analysis pages for now
+	page.AvatarURL, _ = c.Locals("AvatarURL").(string)
+	return page
+}
+
+// analyzerCanonical is the canonical URL of an /analyzer page.
+func analyzerCanonical(name, surname, day string) string {
+	u := fmt.Sprintf("https://xn--b3cu8e7ah6h.com/analyzer?name=%s&day=%s", url.QueryEscape(name), url.QueryEscape(day))
+	if surname != "" {
+		u += "&surname=" + url.QueryEscape(surname)
+	}
+	return u
+}
+
 func (h *NumerologyHandler) AnalyzeStreaming(c *fiber.Ctx) error {
 	// Parse query params
 	name := service.SanitizeInput(c.Query("name"))
+	surname := service.SanitizeInput(c.Query("surname"))
 	day := strings.ToLower(strings.TrimSpace(c.Query("day")))
 	if day == "" {
 		day = strings.ToLower(strings.TrimSpace(c.Query("birth_day")))
@@ -459,7 +519,7 @@ func (h *NumerologyHandler) AnalyzeStreaming(c *fiber.Ctx) error {
 		}
 	}
 	if day == "" {
-		day = "thursday"
+		day = defaultBirthDay(c)
 	}
 	isAuspicious := c.Query("auspicious") == "true" || c.Query("auspicious") == "on" ||
 		c.Query("is_auspicious") == "true" || c.Query("is_auspicious") == "on"
@@ -467,10 +527,29 @@ func (h *NumerologyHandler) AnalyzeStreaming(c *fiber.Ctx) error {
 	disableKlakiniTable := disableKlakini
 	disableKlakiniTop4 := c.Query("disable_klakini_top4") == "true" || c.Query("disable_klakini_top4") == "on"
 	repoAllowKlakini := !disableKlakiniTable // Main search should respect table toggle for accurate progress reporting
+	search := nameSearchParams(c)
 
 	isVIP := c.Locals("IsVIP") == true
 	isAdmin := c.Locals("IsAdmin") == true
 
+	// Letters without a value would score as 0; name them instead of analysing
+	if err := h.validateName(name, surname); err != nil {
+		c.Status(fiber.StatusBadRequest)
+		return templ_render.Render(c, analysis.Index(analysis.IndexProps{
+			Layout: layoutProps(c, analysis.LayoutProps{
+				Title:      fmt.Sprintf("วิเคราะห์ชื่อ %s", name),
+				Canonical:  analyzerCanonical(name, surname, day),
+				ActivePage: "analyzer",
+			}),
+			DefaultName:        name,
+			DefaultDay:         strings.ToUpper(day),
+			SampleNames:        samples,
+			IsVIP:              isVIP,
+			SolarSystemInitial: analysis.NameValidationError(err.Error()),
+			Results:            templ.NopComponent,
+		}))
+	}
+
 	// Calculate Solar System Props (needed for visualization)
 	log.Printf("⏱️  [PERF] Starting getSolarSystemProps for name: %s", name)
 	startSolar := time.Now()
@@ -481,22 +560,14 @@ func (h *NumerologyHandler) AnalyzeStreaming(c *fiber.Ctx) error {
 
 	// Prepare minimal Props for Index (Render Shell Immediately)
 	indexProps := analysis.IndexProps{
-		Layout: analysis.LayoutProps{
-			Title:              fmt.Sprintf("วิเคราะห์ชื่อ %s ผลรวมเลขศาสตร์ พลังเงา", name),
-			Description:        fmt.Sprintf("ผลวิเคราะห์ชื่อ %s สำหรับผู้ที่เกิดวัน %s วิเคราะห์คะแนนเลขศาสตร์และพลังเงา พร้อมตรวจสอบอักษรกาลกิณีอย่างละเอียด", name, service.GetThaiDay(day)),
-			Keywords:           fmt.Sprintf("วิเคราะห์ชื่อ %s, ชื่อมงคล %s, เลขศาสตร์ %s, พลังเงา %s", name, name, name, name),
-			Canonical:          fmt.Sprintf("https://xn--b3cu8e7ah6h.com/analyzer?name=%s&day=%s", url.QueryEscape(name), url.QueryEscape(day)),
-			OGImage:            "https://xn--b3cu8e7ah6h.com/static/og-analyzer.png",
-			OGType:             "website",
-			IsLoggedIn:         c.Locals("IsLoggedIn") == true,
-			IsAdmin:            c.Locals("IsAdmin") == true,
-			ActivePage:         "analyzer",
-			ToastSuccess:       c.Locals("toast_success"),
-			ToastError:         c.Locals("toast_error"),
-			IsVIP:              isVIP,
-			HasShippingAddress: true, // Suppress notification on analyzer page for now
-			AvatarURL:          func() string { s, _ := c.Locals("AvatarURL").(string); return s }(),
-		},
+		Layout: layoutProps(c, analysis.LayoutProps{
+			Title:       fmt.Sprintf("วิเคราะห์ชื่อ %s ผลรวมเลขศาสตร์ พลังเงา", name),
+			Description: fmt.Sprintf("ผลวิเคราะห์ชื่อ %s สำหรับผู้ที่เกิดวัน %s วิเคราะห์คะแนนเลขศาสตร์และพลังเงา พร้อมตรวจสอบอักษรกาลกิณีอย่างละเอียด", name, service.GetThaiDay(day)),
+			Keywords:    fmt.Sprintf("วิเคราะห์ชื่อ %s, ชื่อมงคล %s, เลขศาสตร์ %s, พลังเงา %s", name, name, name, name),
+			Canonical:   analyzerCanonical(name, surname, day),
+			OGImage:     "https://xn--b3cu8e7ah6h.com/static/og-analyzer.png",
+			ActivePage:  "analyzer",
+		}),
 		DefaultName:           name,
 		DefaultDay:            strings.ToUpper(day),
 		SampleNames:           samples,
@@ -504,6 +575,9 @@ func (h *NumerologyHandler) AnalyzeStreaming(c *fiber.Ctx) error {
 		HeaderDisplayNameHTML: h.createHeaderDisplayChars(name, day),
 		SolarSystem:           solarProps,
 	}
+	if surname != "" {
+		indexProps.FullName = h.engine.AnalyzeFullName(name, surname, day, numerology.Options{})
+	}
 
 	// 1. Set Headers for Streaming
 	c.Set("Content-Type", "text/html; charset=utf-8")
@@ -512,14 +586,18 @@ func (h *NumerologyHandler) AnalyzeStreaming(c *fiber.Ctx) error {
 	c.Set("Pragma", "no-cache")
 	c.Set("Expires", "0")
 	c.Set("X-Accel-Buffering", "no")
+	client, vip := searchClient(c)
 
 	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		// Cancelled once the client is gone, so it leaves the search queue
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
 		flush := func() {
-			w.Flush()
+			if err := w.Flush(); err != nil {
+				cancel()
+			}
 		}
 
-		ctx := context.Background()
-
 		// Prepare Header Props for Initial Render
 		displayNameHTML := h.createDisplayChars(name, day)
 		var klakiniChars []string
@@ -552,10 +630,23 @@ func (h *NumerologyHandler) AnalyzeStreaming(c *fiber.Ctx) error {
 			if f, ok := w2.(http.Flusher); ok {
 				f.Flush()
 			} else {
-				w.Flush()
+				flush()
 			}
 
 			// --- HEAVY LIFTING STARTS HERE (Progressive) ---
+			// Wait for a search slot, showing the place in the queue over the table skeleton
+			release, err := h.scheduler.Acquire(ctx, client, vip, func(position int) {
+				analysis.SwapContent("similar-names-list-section", analysis.SearchQueueNotice(position)).Render(ctx, w2)
+				flush()
+			})
+			if err != nil {
+				log.Printf("Search not started for %s: %v", name, err)
+				analysis.SwapContent("top4-section", templ.NopComponent).Render(ctx, w2)
+				analysis.SwapContent("similar-names-list-section", analysis.SearchQueueError(searchQueueMessage(err))).Render(ctx, w2)
+				flush()
+				return nil
+			}
+			defer release()
 
 			// --- PHASE 1: Top 4 (Fast-ish if indexed) ---
 			normalizedInput := strings.TrimSpace(name)
@@ -563,15 +654,17 @@ func (h *NumerologyHandler) AnalyzeStreaming(c *fiber.Ctx) error {
 			log.Printf("⏱️  [PERF] Starting GetBestSimilarNames (fetch 100, show top 4)")
 			startBest := time.Now()
 			// Fetch 100 names for proper ranking calculation
-			bestCandidates, errBest := h.namesMiracleRepo.GetBestSimilarNames(name, day, 100, allowKlakiniTop4)
+			bestCandidates, errBest := h.bestSimilarNames(name, day, 100, allowKlakiniTop4)
 			log.Printf("✅ [PERF] GetBestSimilarNames completed in: %v (found %d names)", time.Since(startBest), len(bestCandidates))
+			if errBest == nil && len(bestCandidates) > 0 {
+				bestCandidates = h.rankWithSurname(bestCandidates, surname, day, true)
+			}
 
 			var top4, last4, fullBestList []domain.SimilarNameResult
 			var totalCountBest int
 
 			// If we got Best Names independently, render them now!
 			if errBest == nil && len(bestCandidates) > 0 {
-				h.calculateScoresAndHighlights(bestCandidates, day)
 				// Get all ranked names first
 				t4, l4, fl, totalCount := h.getBestNames(bestCandidates, 100, allowKlakiniTop4)
 				totalCountBest = totalCount
@@ -600,7 +693,7 @@ func (h *NumerologyHandler) AnalyzeStreaming(c *fiber.Ctx) error {
 				if f, ok := w2.(http.Flusher); ok {
 					f.Flush()
 				} else {
-					w.Flush()
+					flush()
 				}
 			}
 
@@ -609,7 +702,7 @@ func (h *NumerologyHandler) AnalyzeStreaming(c *fiber.Ctx) error {
 			if isVIP || isAdmin {
 				limit = 1000
 			}
-			similarNames, err := h.fetchSimilarNames(name, day, false, repoAllowKlakini, limit, nil)
+			similarNames, err := h.fetchSimilarNames(name, day, search, false, repoAllowKlakini, limit, nil)
 			if err != nil {
 				log.Printf("ERROR: fetchSimilarNames failed: %v", err)
 				return nil
@@ -623,6 +716,8 @@ func (h *NumerologyHandler) AnalyzeStreaming(c *fiber.Ctx) error {
 				}
 			}
 
+			similarNames = h.rankWithSurname(similarNames, surname, day, isAuspicious)
+
 			// If Top4 failed earlier (Fallback), calculate it now using full list
 			if errBest != nil || len(bestCandidates) == 0 {
 				bestCandidates = make([]domain.SimilarNameResult, len(similarNames))
@@ -659,7 +754,7 @@ func (h *NumerologyHandler) AnalyzeStreaming(c *fiber.Ctx) error {
 				if f, ok := w2.(http.Flusher); ok {
 					f.Flush()
 				} else {
-					w.Flush()
+					flush()
 				}
 			} else {
 				// We still need to calculate scores for the Table view mostly
@@ -709,7 +804,7 @@ func (h *NumerologyHandler) AnalyzeStreaming(c *fiber.Ctx) error {
 			if f, ok := w2.(http.Flusher); ok {
 				f.Flush()
 			} else {
-				w.Flush()
+				flush()
 			}
 			return nil
 		})
@@ -735,24 +830,13 @@ func (h *NumerologyHandler) AnalyzeLinguistically(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).SendString("Name parameter is required.")
 	}
 
-	// 1. Try Cache
-	var cachedMarkdown string
-	err := h.db.QueryRow("SELECT analysis_markdown FROM linguistic_cache WHERE name_text = $1", name).Scan(&cachedMarkdown)
-	if err == nil && cachedMarkdown != "" {
-		log.Printf("DEBUG: Linguistic Cache HIT for '%s'", name)
-		return templ_render.Render(c, analysis.LinguisticModal(name, cachedMarkdown))
-	}
-
-	// 2. AI Call
-	analysisRes, err := h.linguisticService.AnalyzeName(name)
+	// The dictionary answers first; LLM answers are kept there, so it doubles as the cache.
+	analysisRes, _, err := h.linguisticService.AnalyzeName(name)
 	if err != nil {
 		log.Printf("Error from linguistic service: %v", err)
 		return c.Status(fiber.StatusInternalServerError).SendString(fmt.Sprintf("Service Error: %v", err))
 	}
 
-	// 3. Save to Cache (Background or Sync - let's do sync for simplicity and reliability)
-	_, _ = h.db.Exec("INSERT INTO linguistic_cache (name_text, analysis_markdown) VALUES ($1, $2) ON CONFLICT (name_text) DO NOTHING", name, analysisRes)
-
 	return templ_render.Render(c, analysis.LinguisticModal(name, analysisRes))
 }
 
@@ -762,9 +846,10 @@ func (h *NumerologyHandler) GetBadNumbersAPI(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to load meanings"})
 	}
 
+	rs := h.engine.Ruleset()
 	uniqueBad := make(map[int]bool)
 	for _, m := range meanings {
-		if service.IsBadPairType(m.PairType) {
+		if rs.IsBad(m.PairType) {
 			if val, err := strconv.Atoi(m.PairNumber); err == nil {
 				uniqueBad[val] = true
 			}
@@ -790,8 +875,10 @@ func (h *NumerologyHandler) GetNumberMeanings(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusInternalServerError).SendString("Error loading meanings.")
 	}
 
+	rs := h.engine.Ruleset()
 	var meanings []domain.NumberPairMeaning
 	for _, meaning := range meaningsMap {
+		meaning.Color = rs.TierColor(meaning.PairType)
 		meanings = append(meanings, meaning)
 	}
 
@@ -802,206 +889,450 @@ func (h *NumerologyHandler) GetNumberMeanings(c *fiber.Ctx) error {
 	return templ_render.Render(c, analysis.NumberMeaningsModal(meanings))
 }
 
-// AnalyzeLinguisticallyAPI returns JSON for mobile apps
-func (h *NumerologyHandler) AnalyzeLinguisticallyAPI(c *fiber.Ctx) error {
+// RepairNameAPI suggests the smallest spelling changes that clear every bad pair of a name.
+// Unlike GetSimilarNames it does not look up names_miracle; variants are generated and scored on the fly.
+func (h *NumerologyHandler) RepairNameAPI(c *fiber.Ctx) error {
 	name := service.SanitizeInput(c.Query("name"))
 	if name == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Name is required"})
 	}
+	day := strings.ToLower(strings.TrimSpace(c.Query("day")))
+	if day == "" {
+		day = "thursday"
+	}
+	limit := 20
+	if val, err := strconv.Atoi(c.Query("limit")); err == nil && val > 0 && val <= 100 {
+		limit = val
+	}
 
-	// Try Cache
-	var cachedMarkdown string
-	err := h.db.QueryRow("SELECT analysis_markdown FROM linguistic_cache WHERE name_text = $1", name).Scan(&cachedMarkdown)
-	if err == nil && cachedMarkdown != "" {
-		return c.JSON(fiber.Map{
-			"name":     name,
-			"analysis": cachedMarkdown,
-			"source":   "cache",
-		})
+	original := h.engine.Analyze(name, day, numerology.Options{})
+	repairs := h.engine.Repair(name, day, limit)
+	if repairs == nil {
+		repairs = []domain.NameRepair{}
 	}
 
-	analysisRes, err := h.linguisticService.AnalyzeName(name)
+	return c.JSON(fiber.Map{
+		"name":          name,
+		"day":           day,
+		"sat_num":       original.SatNum,
+		"sha_num":       original.ShaNum,
+		"t_sat":         original.TSat,
+		"t_sha":         original.TSha,
+		"total_score":   original.TotalScore,
+		"has_bad_pair":  original.HasBadPair,
+		"klakini_chars": original.KlakiniChars,
+		"repairs":       repairs,
+	})
+}
+
+// SpellingVariantsAPI lists spellings that read the same as the name, best scoring first.
+func (h *NumerologyHandler) SpellingVariantsAPI(c *fiber.Ctx) error {
+	name, day, limit, err := spellingVariantParams(c)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": fmt.Sprintf("Analysis failed: %v", err)})
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
 
-	// Save
-	_, _ = h.db.Exec("INSERT INTO linguistic_cache (name_text, analysis_markdown) VALUES ($1, $2) ON CONFLICT (name_text) DO NOTHING", name, analysisRes)
+	variants := h.engine.SpellingVariants(name, day, limit)
+	var best *domain.SpellingVariant
+	if len(variants) > 0 {
+		best = &variants[0]
+	}
 
 	return c.JSON(fiber.Map{
 		"name":     name,
-		"analysis": analysisRes,
+		"day":      day,
+		"best":     best,
+		"variants": variants,
 	})
 }
 
-// calculateScoresAndHighlights calculates scores and also generates the DisplayNameHTML for each name.
-func (h *NumerologyHandler) calculateScoresAndHighlights(names []domain.SimilarNameResult, day string) {
-	for i := range names {
-		satMeanings, satPos, satNeg := getMeaningsAndScores(names[i].SatNum, h.numberPairCache)
-		shaMeanings, shaPos, shaNeg := getMeaningsAndScores(names[i].ShaNum, h.numberPairCache)
+// SpellingVariants renders the spelling variants modal of the analyzer page.
+func (h *NumerologyHandler) SpellingVariants(c *fiber.Ctx) error {
+	name, day, limit, err := spellingVariantParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+	}
+	return templ_render.Render(c, analysis.SpellingVariantsModal(name, day, h.engine.SpellingVariants(name, day, limit)))
+}
 
-		names[i].TotalScore = satPos + satNeg + shaPos + shaNeg
+func spellingVariantParams(c *fiber.Ctx) (string, string, int, error) {
+	name := service.SanitizeInput(c.Query("name"))
+	if name == "" {
+		return "", "", 0, fmt.Errorf("Name is required")
+	}
+	day := strings.ToLower(strings.TrimSpace(c.Query("day")))
+	if day == "" {
+		day = "thursday"
+	}
+	limit := 30
+	if val, err := strconv.Atoi(c.Query("limit")); err == nil && val > 0 && val <= 200 {
+		limit = val
+	}
+	return name, day, limit, nil
+}
 
-		// HasBadPair detection must match visual logic (Red circles)
-		hasBad := false
-		for _, m := range satMeanings {
-			if service.IsBadPairType(m.Meaning.PairType) {
-				hasBad = true
-				break
-			}
-		}
-		if !hasBad {
-			for _, m := range shaMeanings {
-				if service.IsBadPairType(m.Meaning.PairType) {
-					hasBad = true
-					break
-				}
-			}
-		}
-		names[i].HasBadPair = hasBad
+// CompareAPI scores two to five shortlisted names side by side for one birth day
+// and recommends a winner.
+func (h *NumerologyHandler) CompareAPI(c *fiber.Ctx) error {
+	names, day, err := h.compareParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(unmappedCharsResponse(err))
+	}
+	return c.JSON(h.engine.Compare(names, day))
+}
 
-		// Premium/TopTier Logic:
-		// 1. Must NOT have any BAD pairs (R10, R7, R5)
-		// 2. Must have AT LEAST ONE GOOD pair (D10, D8, D5)
-		// Apply this check to both Numerology (Sat) and Shadow (Sha) pillars.
+// ComparePage renders the comparison form and, when names are given, the matrix.
+func (h *NumerologyHandler) ComparePage(c *fiber.Ctx) error {
+	props := analysis.ComparePageProps{
+		Layout: layoutProps(c, analysis.LayoutProps{
+			Title:       "เปรียบเทียบชื่อ วิเคราะห์เลขศาสตร์และพลังเงา",
+			Description: "เปรียบเทียบชื่อที่เลือกไว้ 2-5 ชื่อ ดูคู่เลข คะแนนรวม อักษรกาลกิณี และชื่อที่แนะนำ",
+			Keywords:    "เปรียบเทียบชื่อ, ชื่อมงคล, เลขศาสตร์, พลังเงา",
+			Canonical:   "https://xn--b3cu8e7ah6h.com/compare",
+			ActivePage:  "compare",
+		}),
+		Day: "thursday",
+	}
+
+	names, day, err := h.compareParams(c)
+	props.Names = names
+	if day != "" {
+		props.Day = day
+	}
+	switch {
+	case len(names) == 0:
+		// Empty form
+	case err != nil:
+		props.Error = err.Error()
+	default:
+		props.Comparison = h.engine.Compare(names, day)
+	}
+
+	return templ_render.Render(c, analysis.ComparePage(props))
+}
 
-		isPillarStrictPremium := func(pairs []string) bool {
-			if len(pairs) == 0 {
-				return false
-			}
-			for _, p := range pairs {
-				if m, ok := h.numberPairCache.GetMeaning(p); ok {
-					if !service.IsGoodPairType(m.PairType) {
-						fmt.Printf("DEBUG: %s Pair %s Type '%s' NOT Good\n", names[i].ThName, p, m.PairType)
-						return false // Found non-Good pair (Neutral or Bad)
-					}
-				} else {
-					fmt.Printf("DEBUG: %s Pair %s Unknown/Not Found in Cache\n", names[i].ThName, p)
-					return false // Unknown pair is not Good
-				}
-			}
-			// No bad pairs found - this is a premium name
-			return true
-		}
+// compareParams reads names from a comma separated "names" parameter and/or
+// repeated "name" parameters. Duplicates and blanks are dropped.
+func (h *NumerologyHandler) compareParams(c *fiber.Ctx) ([]string, string, error) {
+	var raw []string
+	raw = append(raw, strings.Split(c.Query("names"), ",")...)
+	for _, v := range c.Context().QueryArgs().PeekMulti("name") {
+		raw = append(raw, string(v))
+	}
 
-		satP := isPillarStrictPremium(names[i].SatNum)
-		shaP := isPillarStrictPremium(names[i].ShaNum)
-		names[i].IsTopTier = satP && shaP
-		if names[i].IsTopTier {
-			// fmt.Printf("DEBUG: PREMIUM FOUND: %s\n", names[i].ThName)
+	seen := make(map[string]bool)
+	var names []string
+	for _, r := range raw {
+		name := service.SanitizeInput(r)
+		if name == "" || seen[name] {
+			continue
 		}
+		seen[name] = true
+		names = append(names, name)
+	}
 
-		// Generate display characters for the name with true Klakini status.
-		names[i].DisplayNameHTML = h.createDisplayChars(names[i].ThName, day)
+	day := strings.ToLower(strings.TrimSpace(c.Query("day")))
+	if day == "" {
+		day = "thursday"
+	}
 
-		// Populate the KlakiniChars field with actual Klakini characters.
-		var klakiniChars []string
-		for _, r := range names[i].ThName {
-			if h.klakiniCache.IsKlakini(day, r) {
-				klakiniChars = append(klakiniChars, string(r))
-			}
+	if len(names) < numerology.MinCompareNames || len(names) > numerology.MaxCompareNames {
+		return names, day, fmt.Errorf("กรุณาระบุชื่อ %d-%d ชื่อเพื่อเปรียบเทียบ", numerology.MinCompareNames, numerology.MaxCompareNames)
+	}
+	for _, name := range names {
+		if err := h.validateName(name, ""); err != nil {
+			return names, day, err
 		}
-		names[i].KlakiniChars = klakiniChars
+	}
+	return names, day, nil
+}
 
-		// Calculate Category counts - Initialize all categories with 0
-		counts := map[string]int{
-			"การงาน":  0,
-			"การเงิน": 0,
-			"ความรัก": 0,
-			"สุขภาพ":  0,
-		}
+// analyzeBusinessAPI is AnalyzeAPI with mode=business: the day comes from the
+// opening date and brand affixes are returned instead of similar names.
+func (h *NumerologyHandler) analyzeBusinessAPI(c *fiber.Ctx) error {
+	name, day, opening, err := h.businessParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(unmappedCharsResponse(err))
+	}
+	result := h.engine.AnalyzeBusiness(name, day)
+	result.Opening = opening
+	return c.JSON(fiber.Map{
+		"mode":            "business",
+		"business":        result,
+		"ruleset_version": h.engine.Ruleset().Version,
+	})
+}
 
-		for _, p := range names[i].SatNum {
-			if cats, ok := h.numberCategoryCache.GetCategories(p); ok {
-				for _, c := range cats {
-					counts[c]++
-				}
-			}
-		}
-		for _, p := range names[i].ShaNum {
-			if cats, ok := h.numberCategoryCache.GetCategories(p); ok {
-				for _, c := range cats {
-					counts[c]++
-				}
-			}
-		}
-		names[i].CategoryCounts = counts
+// BusinessPage renders the business name form and, when a name is given, its analysis.
+func (h *NumerologyHandler) BusinessPage(c *fiber.Ctx) error {
+	props := analysis.BusinessPageProps{
+		Layout: layoutProps(c, analysis.LayoutProps{
+			Title:       "วิเคราะห์ชื่อร้าน ชื่อธุรกิจ ตามวันเปิดกิจการ",
+			Description: "วิเคราะห์ชื่อร้านและแบรนด์ทั้งภาษาไทย อังกฤษ และตัวเลข เน้นด้านการเงินและการงาน พร้อมคำเติมหน้า-หลังชื่อที่ทำให้ผลรวมดี",
+			Keywords:    "ชื่อร้านมงคล, ชื่อธุรกิจ, ชื่อแบรนด์, เลขศาสตร์, วันเปิดร้าน",
+			Canonical:   "https://xn--b3cu8e7ah6h.com/business",
+			ActivePage:  "business",
+		}),
+		OpeningDate: c.Query("opening_date"),
+	}
+
+	name, day, opening, err := h.businessParams(c)
+	props.Name, props.Day = name, day
+	switch {
+	case name == "":
+		// Empty form
+	case err != nil:
+		props.Error = err.Error()
+	default:
+		props.Result = h.engine.AnalyzeBusiness(name, day)
+		props.Result.Opening = opening
+	}
+
+	return templ_render.Render(c, analysis.BusinessPage(props))
+}
 
-		names[i].TSat = make([]domain.PairTypeInfo, len(satMeanings))
-		for j, meaning := range satMeanings {
-			color := meaning.Meaning.Color
-			// Force RED for bad pairs to fix "sad brown" color issue
-			if service.IsBadPairType(meaning.Meaning.PairType) {
-				color = "#D32F2F"
-			}
-			names[i].TSat[j] = domain.PairTypeInfo{Type: meaning.Meaning.PairType, Color: color}
-		}
-		names[i].TSha = make([]domain.PairTypeInfo, len(shaMeanings))
-		for j, meaning := range shaMeanings {
-			color := meaning.Meaning.Color
-			// Force RED for bad pairs to fix "sad brown" color issue
-			if service.IsBadPairType(meaning.Meaning.PairType) {
-				color = "#D32F2F"
-			}
-			names[i].TSha[j] = domain.PairTypeInfo{Type: meaning.Meaning.PairType, Color: color}
+// businessParams reads the brand name and its day: from opening_date (and
+// optional opening_time) when given, otherwise from day.
+func (h *NumerologyHandler) businessParams(c *fiber.Ctx) (string, string, *domain.BirthMoment, error) {
+	name := service.SanitizeBrandInput(c.Query("name"))
+	day := strings.ToLower(strings.TrimSpace(c.Query("day")))
+	if day == "" {
+		day = "thursday"
+	}
+
+	var opening *domain.BirthMoment
+	if date := strings.TrimSpace(c.Query("opening_date")); date != "" {
+		m, err := domain.ParseBirthMoment(date, c.Query("opening_time"), domain.DayBoundaryMidnight)
+		if err != nil {
+			return name, day, nil, err
 		}
+		opening, day = m, m.Day()
 	}
+
+	if name == "" {
+		return name, day, opening, errors.New("กรุณาระบุชื่อร้านหรือชื่อธุรกิจ")
+	}
+	return name, day, opening, h.engine.ValidateBrand(name)
 }
 
-func (h *NumerologyHandler) isAllPairsTopTier(name string, pairs []string, pairCache *cache.NumberPairCache) bool {
-	for _, p := range pairs {
-		if p == "" {
-			continue
-		}
-		if meaning, ok := pairCache.GetMeaning(p); ok {
-			switch meaning.PairType {
-			case "D10", "D8", "D5":
-			default:
-				return false
-			}
-		} else {
-			return false
-		}
+// PlateAnalysisAPI analyses a licence plate for the owner's birth day.
+func (h *NumerologyHandler) PlateAnalysisAPI(c *fiber.Ctx) error {
+	plate := strings.TrimSpace(c.Query("plate"))
+	if plate == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Plate is required"})
+	}
+	day, birth, err := birthDayParam(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
-	return true
+	result, err := h.engine.AnalyzePlate(plate, day)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(unmappedCharsResponse(err))
+	}
+
+	resp := fiber.Map{
+		"plate":           result,
+		"ruleset_version": h.engine.Ruleset().Version,
+	}
+	if birth != nil {
+		resp["birth"] = birth
+	}
+	return c.JSON(resp)
 }
 
-// findBestConsonant finds the first consonant in a name that is not a klakini for the given day.
-func (h *NumerologyHandler) findBestConsonant(name, day string) string {
-	firstConsonant := ""
-	for _, r := range name {
-		// Skip leading vowels
-		switch r {
-		case 'เ', 'แ', 'โ', 'ใ', 'ไ':
-			continue
+// PlateAnalysisPage renders the plate form and, when a plate is given, its reading.
+func (h *NumerologyHandler) PlateAnalysisPage(c *fiber.Ctx) error {
+	props := analysis.PlatePageProps{
+		Layout: layoutProps(c, analysis.LayoutProps{
+			Title:       "วิเคราะห์ทะเบียนรถ ผลรวมเลขทะเบียนมงคล",
+			Description: "วิเคราะห์ทะเบียนรถตามหลักเลขศาสตร์ รวมค่าตัวอักษรและตัวเลข ดูความหมายผลรวมและอักษรกาลกิณีตามวันเกิดเจ้าของรถ",
+			Keywords:    "ทะเบียนรถมงคล, ผลรวมทะเบียนรถ, เลขทะเบียน, เลขศาสตร์",
+			Canonical:   "https://xn--b3cu8e7ah6h.com/plate-analysis",
+			ActivePage:  "plate-analysis",
+		}),
+		Plate: strings.TrimSpace(c.Query("plate")),
+	}
+
+	day, _, err := birthDayParam(c)
+	props.Day = day
+	switch {
+	case props.Plate == "":
+		// Empty form
+	case err != nil:
+		props.Error = err.Error()
+	default:
+		props.Result, err = h.engine.AnalyzePlate(props.Plate, day)
+		if err != nil {
+			props.Error = err.Error()
 		}
-		// Check if the character is a klakini
-		if !h.klakiniCache.IsKlakini(day, r) {
-			return string(r) // Found a non-klakini consonant
+	}
+
+	return templ_render.Render(c, analysis.PlatePage(props))
+}
+
+// AnalyzeLinguisticallyAPI returns JSON for mobile apps
+func (h *NumerologyHandler) AnalyzeLinguisticallyAPI(c *fiber.Ctx) error {
+	name := service.SanitizeInput(c.Query("name"))
+	if name == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Name is required"})
+	}
+
+	analysisRes, source, err := h.linguisticService.AnalyzeName(name)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": fmt.Sprintf("Analysis failed: %v", err)})
+	}
+
+	return c.JSON(fiber.Map{
+		"name":     name,
+		"analysis": analysisRes,
+		"source":   source, // dictionary, llm or mock
+	})
+}
+
+// calculateScoresAndHighlights scores each stored name with the engine and generates its DisplayNameHTML.
+func (h *NumerologyHandler) calculateScoresAndHighlights(names []domain.SimilarNameResult, day string) {
+	for i := range names {
+		result := h.engine.AnalyzePairs(names[i].ThName, day, names[i].SatNum, names[i].ShaNum, numerology.Options{})
+
+		names[i].TotalScore = result.TotalScore
+		names[i].HasBadPair = result.HasBadPair
+		names[i].IsTopTier = result.IsTopTier
+		names[i].DisplayNameHTML = result.DisplayChars
+		names[i].KlakiniChars = result.KlakiniChars
+		names[i].CategoryCounts = result.CategoryCounts
+		names[i].TSat = result.TSat
+		names[i].TSha = result.TSha
+		names[i].LeadingTaksa = result.LeadingTaksa
+	}
+}
+
+// explain returns the lookup trace of the name, and of the surname when one was given.
+func (h *NumerologyHandler) explain(name, surname string) fiber.Map {
+	trace := fiber.Map{"first": h.engine.Explain(name)}
+	if surname != "" {
+		trace["last"] = h.engine.Explain(surname)
+	}
+	return trace
+}
+
+// birthDayParam resolves the birth day of an analysis request. A birth moment
+// (birth_date with optional birth_time and day_boundary, or a single birth
+// datetime) takes precedence over the raw day / birth_day parameters.
+func birthDayParam(c *fiber.Ctx) (string, *domain.BirthMoment, error) {
+	date := c.Query("birth_date")
+	if date == "" {
+		date = c.Query("birth")
+	}
+	if strings.TrimSpace(date) != "" {
+		moment, err := domain.ParseBirthMoment(date, c.Query("birth_time"), c.Query("day_boundary"))
+		if err != nil {
+			return "", nil, err
 		}
-		// If it's a klakini but it's the first consonant we've seen, store it as a fallback
-		if firstConsonant == "" {
-			firstConsonant = string(r)
+		return moment.Day(), moment, nil
+	}
+
+	day := strings.ToLower(strings.TrimSpace(c.Query("day")))
+	if day == "" {
+		day = strings.ToLower(strings.TrimSpace(c.Query("birth_day")))
+	}
+	if day == "" {
+		day = defaultBirthDay(c)
+	}
+	return day, nil, nil
+}
+
+// defaultBirthDay is the birth day of the logged-in member, or Thursday.
+func defaultBirthDay(c *fiber.Ctx) string {
+	if day, ok := c.Locals("BirthDay").(string); ok && day != "" {
+		return day
+	}
+	return "thursday"
+}
+
+// validateName rejects names containing characters without a Thai or Latin value,
+// which would otherwise silently score as 0.
+func (h *NumerologyHandler) validateName(name, surname string) error {
+	if err := h.engine.Validate(name); err != nil {
+		return err
+	}
+	return h.engine.Validate(surname)
+}
+
+func unmappedCharsResponse(err error) fiber.Map {
+	resp := fiber.Map{"error": err.Error()}
+	var unmapped *numerology.UnmappedCharError
+	if errors.As(err, &unmapped) {
+		resp["unmapped_chars"] = unmapped.Chars
+	}
+	return resp
+}
+
+// rankWithSurname keeps the surname fixed and scores each suggested first name as part
+// of the full name. Names whose combined pillar is good move ahead of the rest; with
+// goodOnly, names whose combined pillar has a bad pair are dropped.
+func (h *NumerologyHandler) rankWithSurname(names []domain.SimilarNameResult, surname, day string, goodOnly bool) []domain.SimilarNameResult {
+	if surname == "" || len(names) == 0 {
+		return names
+	}
+
+	last := h.engine.Analyze(surname, day, numerology.Options{})
+	var topTier, good, bad []domain.SimilarNameResult
+	for _, n := range names {
+		first := h.engine.Analyze(n.ThName, day, numerology.Options{})
+		n.Combined = h.engine.Combine(first, last, day, numerology.Options{}).ToCombinedPillar()
+		switch {
+		case n.Combined.IsTopTier:
+			topTier = append(topTier, n)
+		case !n.Combined.HasBadPair:
+			good = append(good, n)
+		default:
+			bad = append(bad, n)
 		}
 	}
-	// If all consonants are klakini, return the first one found. If no consonants, return empty.
-	return firstConsonant
+
+	ranked := append(topTier, good...)
+	if !goodOnly {
+		ranked = append(ranked, bad...)
+	}
+	if ranked == nil {
+		ranked = []domain.SimilarNameResult{}
+	}
+	return ranked
+}
+
+// nameSearchParams reads the ranking, filters and sort of a name search from the
+// query string: rank, gender, syllables, first_letter, category and sort.
+func nameSearchParams(c *fiber.Ctx) domain.NameSearchOptions {
+	return domain.ParseNameSearchOptions(
+		c.Query("rank"),
+		c.Query("gender"),
+		c.Query("syllables"),
+		c.Query("first_letter"),
+		c.Query("category"),
+		c.Query("sort"),
+	)
 }
 
-func (h *NumerologyHandler) findAuspiciousNames(name, day string, repoAllowKlakini, findGoodOnly bool, limit int, onProgress func(int, int)) ([]domain.SimilarNameResult, error) {
+// findAuspiciousNames ranks names as search asks (ranking, filters and sort).
+func (h *NumerologyHandler) findAuspiciousNames(name, day string, search domain.NameSearchOptions, repoAllowKlakini, findGoodOnly bool, limit int, onProgress func(int, int)) ([]domain.SimilarNameResult, error) {
 	// User's logic at backend is strictly similarity-based (Levenshtein-like)
 	// without caring about vowels or consonants.
 	preferredConsonant := ""
 
 	// Single efficient DB call. Database filters by similarity, Klakini, and Good Only rules.
 	// This ensures we scan the entire table in the most optimal way.
-	auspiciousNames, err := h.namesMiracleRepo.GetAuspiciousNames(name, preferredConsonant, day, limit, 0, repoAllowKlakini, findGoodOnly)
+	auspiciousNames, err := h.namesMiracleRepo.GetAuspiciousNames(name, preferredConsonant, day, limit, 0, repoAllowKlakini, findGoodOnly, search)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching auspicious names: %w", err)
 	}
 
 	// Calculate scores for display
 	h.calculateScoresAndHighlights(auspiciousNames, day)
+	// Names starting with a เดช or ศรี letter for the day move up, unless the
+	// user picked another sort
+	if search.Sort == domain.SortSimilarity {
+		numerology.BoostLeadingTaksa(auspiciousNames)
+	}
 
 	// Report 100% progress
 	if onProgress != nil {
@@ -1015,31 +1346,7 @@ func (h *NumerologyHandler) findAuspiciousNames(name, day string, repoAllowKlaki
 // It ALWAYS checks for Klakini characters based on the day, regardless of any search settings.
 // This ensures consistent display (red color) across the application.
 func (h *NumerologyHandler) createDisplayChars(name, day string) []domain.DisplayChar {
-	var result []domain.DisplayChar
-	runes := []rune(name)
-
-	for i := 0; i < len(runes); i++ {
-		r := runes[i]
-		currentStr := string(r)
-		isBad := h.klakiniCache.IsKlakini(day, r)
-
-		// Look ahead for combining marks (vowels, tone marks, etc.)
-		// We loop to consume ALL consecutive combining marks to ensure the whole cluster stays together.
-		for i+1 < len(runes) && (unicode.Is(unicode.Mn, runes[i+1]) || unicode.Is(unicode.Mc, runes[i+1]) || unicode.Is(unicode.Me, runes[i+1])) {
-			i++ // Advance to the combining char
-			combiningChar := runes[i]
-			currentStr += string(combiningChar)
-
-			// If any part of the cluster (base or mark) is Klakini, the whole cluster is displayed as Bad (Red)
-			if h.klakiniCache.IsKlakini(day, combiningChar) {
-				isBad = true
-			}
-		}
-
-		result = append(result, domain.DisplayChar{Char: currentStr, IsBad: isBad})
-	}
-
-	return result
+	return h.engine.DisplayChars(name, day)
 }
 
 // createHeaderDisplayChars returns characters for the header where a Klakini combining mark
@@ -1063,13 +1370,19 @@ func (h *NumerologyHandler) GetSimilarNames(c *fiber.Ctx) error {
 	}
 
 	var similarNames []domain.SimilarNameResult
-	var err error
 	limit := 100
 	if c.Locals("IsVIP") == true {
 		limit = 1000
 	}
+	client, vip := searchClient(c)
+	release, err := h.scheduler.Acquire(c.Context(), client, vip, nil)
+	if err != nil {
+		return c.Status(fiber.StatusServiceUnavailable).SendString(searchQueueMessage(err))
+	}
+	defer release()
+
 	// Always fetch from the general fetcher to get a pool for both cards and table
-	similarNames, err = h.fetchSimilarNames(name, day, false, repoAllowKlakini, limit, nil)
+	similarNames, err = h.fetchSimilarNames(name, day, nameSearchParams(c), false, repoAllowKlakini, limit, nil)
 
 	if err != nil {
 		log.Printf("Error getting names: %v", err)
@@ -1108,10 +1421,8 @@ func (h *NumerologyHandler) GetSimilarNames(c *fiber.Ctx) error {
 
 	// 2. Prepare Best Names Candidates - FETCH SEPARATELY (Turbo Mode)
 	allowKlakiniTop4 := !disableKlakiniTop4
-	bestCandidates, errBest := h.namesMiracleRepo.GetBestSimilarNames(name, day, 100, allowKlakiniTop4)
-	if errBest == nil && len(bestCandidates) > 0 {
-		h.calculateScoresAndHighlights(bestCandidates, day)
-	} else {
+	bestCandidates, errBest := h.bestSimilarNames(name, day, 100, allowKlakiniTop4)
+	if errBest != nil || len(bestCandidates) == 0 {
 		// Fallback: Use similarNames if Turbo fails or returns nothing
 		bestCandidates = make([]domain.SimilarNameResult, len(similarNames))
 		copy(bestCandidates, similarNames)
@@ -1206,6 +1517,13 @@ func (h *NumerologyHandler) Decode(c *fiber.Ctx) error {
 		day = "thursday"
 	}
 
+	if err := h.validateName(name, ""); err != nil {
+		if isHtmxRequest(c) {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(unmappedCharsResponse(err))
+	}
+
 	isVIP := c.Locals("IsVIP") == true
 
 	// Calculate Solar System Props (Fast)
@@ -1342,114 +1660,80 @@ func (h *NumerologyHandler) filterKlakiniNames(names []domain.SimilarNameResult)
 	return filtered
 }
 
-func (h *NumerologyHandler) fetchSimilarNames(name, day string, isAuspicious, repoAllowKlakini bool, limit int, onProgress func(int, int)) ([]domain.SimilarNameResult, error) {
-	return h.fetchSimilarNamesEnhanced(name, day, isAuspicious, repoAllowKlakini, isAuspicious, limit, onProgress)
+func (h *NumerologyHandler) fetchSimilarNames(name, day string, search domain.NameSearchOptions, isAuspicious, repoAllowKlakini bool, limit int, onProgress func(int, int)) ([]domain.SimilarNameResult, error) {
+	return h.fetchSimilarNamesEnhanced(name, day, search, isAuspicious, repoAllowKlakini, isAuspicious, limit, onProgress)
 }
 
-func (h *NumerologyHandler) fetchSimilarNamesEnhanced(name, day string, isAuspicious, repoAllowKlakini, findGoodOnly bool, limit int, onProgress func(int, int)) ([]domain.SimilarNameResult, error) {
+func (h *NumerologyHandler) fetchSimilarNamesEnhanced(name, day string, search domain.NameSearchOptions, isAuspicious, repoAllowKlakini, findGoodOnly bool, limit int, onProgress func(int, int)) ([]domain.SimilarNameResult, error) {
 	// All similarity search modes now use the unified SQL-based search logic.
 	// This ensures consistency, performance, and strict adherence to the requested limit.
-	return h.findAuspiciousNames(name, day, repoAllowKlakini, findGoodOnly, limit, onProgress)
+	key := h.analysisKey(name, day, "similar", limit)
+	key.AllowKlakini, key.GoodOnly, key.Search = repoAllowKlakini, findGoodOnly, search
+	filled := false
+	names, err := h.results.Lookup(key, func() ([]domain.SimilarNameResult, error) {
+		filled = true
+		return h.findAuspiciousNames(name, day, search, repoAllowKlakini, findGoodOnly, limit, onProgress)
+	})
+	// A cached result still completes the progress bar
+	if err == nil && !filled && onProgress != nil {
+		onProgress(len(names), 100)
+	}
+	return names, err
 }
 
-func getMeaningsAndScores(pairs []string, pairCache *cache.NumberPairCache) ([]domain.PairMeaningResult, int, int) {
-	var meanings []domain.PairMeaningResult
-	var posScore, negScore int
-	for _, p := range pairs {
-		if meaning, ok := pairCache.GetMeaning(p); ok {
-			// Force RED color for bad pairs to fix "sad brown" display issue globally
-			if service.IsBadPairType(meaning.PairType) {
-				meaning.Color = "#D32F2F"
-				meaning.IsBad = true
-			}
-			meanings = append(meanings, domain.PairMeaningResult{PairNumber: p, Meaning: meaning})
-			if meaning.PairPoint > 0 {
-				posScore += meaning.PairPoint
-			} else {
-				negScore += meaning.PairPoint
-			}
+// bestSimilarNames fetches the scored candidates of the best names ranking.
+func (h *NumerologyHandler) bestSimilarNames(name, day string, limit int, allowKlakini bool) ([]domain.SimilarNameResult, error) {
+	key := h.analysisKey(name, day, "best", limit)
+	key.AllowKlakini = allowKlakini
+	return h.results.Lookup(key, func() ([]domain.SimilarNameResult, error) {
+		names, err := h.namesMiracleRepo.GetBestSimilarNames(name, day, limit, allowKlakini)
+		if err != nil {
+			return nil, err
 		}
-	}
-	return meanings, posScore, negScore
+		h.calculateScoresAndHighlights(names, day)
+		return names, nil
+	})
 }
 
-func formatTotalValue(total int) []string {
-	s := strconv.Itoa(total)
-	if total < 0 {
-		return []string{}
-	}
-	if len(s) < 2 {
-		return []string{fmt.Sprintf("%02d", total)}
-	}
-	if len(s) == 2 {
-		return []string{s}
+// searchClient names whose search it is for the scheduler, the member when
+// logged in and the IP otherwise, and whether it goes ahead of the queue.
+func searchClient(c *fiber.Ctx) (string, bool) {
+	vip := c.Locals("IsVIP") == true || c.Locals("IsAdmin") == true
+	if id, ok := c.Locals("UserID").(int); ok && id > 0 {
+		return "member:" + strconv.Itoa(id), vip
 	}
+	return "ip:" + c.IP(), vip
+}
 
-	var pairs []string
-	if len(s)%2 != 0 {
-		for i := 0; i < len(s)-1; i++ {
-			pairs = append(pairs, s[i:i+2])
-		}
-	} else {
-		for i := 0; i < len(s); i += 2 {
-			pairs = append(pairs, s[i:i+2])
-		}
+// searchQueueMessage is the message shown when a search never got a slot.
+func searchQueueMessage(err error) string {
+	switch {
+	case errors.Is(err, service.ErrSearchQueueFull):
+		return "ระบบกำลังค้นหาชื่อให้ผู้ใช้จำนวนมาก กรุณาลองใหม่อีกครั้ง"
+	case errors.Is(err, service.ErrSearchQueueTimeout):
+		return "รอคิวค้นหานานเกินไป กรุณาลองใหม่อีกครั้ง"
 	}
-	return pairs
+	return "Search cancelled"
 }
 
-func contains(slice []string, item string) bool {
-	for _, a := range slice {
-		if a == item {
-			return true
-		}
+// analysisKey is the result cache key of a name search scored with the active ruleset.
+func (h *NumerologyHandler) analysisKey(name, day, section string, limit int) cache.AnalysisKey {
+	return cache.AnalysisKey{
+		Name:           strings.TrimSpace(name),
+		Day:            strings.ToLower(strings.TrimSpace(day)),
+		Section:        section,
+		Limit:          limit,
+		RulesetVersion: h.engine.Ruleset().Version,
 	}
-	return false
 }
 
 func (h *NumerologyHandler) getSolarSystemProps(name, day string, repoAllowKlakini bool, isVIP bool) (analysis.SolarSystemProps, error) {
-
-	decodedParts := service.DecodeName(name)
-	var results []domain.DecodedResult
-	var totalNumerologyValue, totalShadowValue int
-	var klakiniChars []string
-
-	for _, thaiChar := range decodedParts {
-		if thaiChar.IsThai {
-			processComponent := func(charStr string) {
-				if charStr == "" {
-					return
-				}
-				numVal, _ := h.numerologyCache.GetValue(charStr)
-				shaVal, _ := h.shadowCache.GetValue(charStr)
-
-				isKlakini := false
-				for _, r := range charStr {
-					if h.klakiniCache.IsKlakini(day, r) {
-						isKlakini = true
-						break
-					}
-				}
-
-				results = append(results, domain.DecodedResult{Character: charStr, NumerologyValue: numVal, ShadowValue: shaVal, IsKlakini: isKlakini})
-				totalNumerologyValue += numVal
-				totalShadowValue += shaVal
-
-				if isKlakini && !contains(klakiniChars, charStr) {
-					klakiniChars = append(klakiniChars, charStr)
-				}
-			}
-			processComponent(thaiChar.Consonant)
-			processComponent(thaiChar.Vowel)
-			processComponent(thaiChar.ToneMark)
-		}
-	}
-
-	numerologyPairs := formatTotalValue(totalNumerologyValue)
-	shadowPairs := formatTotalValue(totalShadowValue)
-	numMeanings, numPos, numNeg := getMeaningsAndScores(numerologyPairs, h.numberPairCache)
-	shaMeanings, shaPos, shaNeg := getMeaningsAndScores(shadowPairs, h.numberPairCache)
-	grandTotalScore := numPos + numNeg + shaPos + shaNeg
+	result := h.engine.Analyze(name, day, numerology.Options{})
+	numMeanings, shaMeanings := result.SatPairs, result.ShaPairs
+	numPos, numNeg := result.SatPositive, result.SatNegative
+	shaPos, shaNeg := result.ShaPositive, result.ShaNegative
+	grandTotalScore := result.TotalScore
+	breakdown := result.CategoryBreakdown
 
 	var allUniquePairs []domain.PairMeaningResult
 	seenPairs := make(map[string]bool)
@@ -1469,141 +1753,9 @@ func (h *NumerologyHandler) getSolarSystemProps(name, day string, repoAllowKlaki
 		return allUniquePairs[i].Meaning.PairPoint > allUniquePairs[j].Meaning.PairPoint
 	})
 
-	// Calculate Category counts - Initialize all categories with 0
-	counts := map[string]int{
-		"การงาน":  0,
-		"การเงิน": 0,
-		"ความรัก": 0,
-		"สุขภาพ":  0,
-	}
-
-	// Calculate Category breakdown (Good vs Bad)
-	breakdown := make(map[string]domain.CategoryBreakdown)
-	for cat := range counts {
-		color := "#A0A0A0"
-		switch cat {
-		case "การงาน":
-			color = "#90CAF9"
-		case "การเงิน":
-			color = "#FFCC80"
-		case "ความรัก":
-			color = "#F48FB1"
-		case "สุขภาพ":
-			color = "#80CBC4"
-		}
-		breakdown[cat] = domain.CategoryBreakdown{Good: 0, Bad: 0, Color: color}
-	}
-
-	// Helper function to update breakdown
-	allowedCategories := map[string]bool{
-		"การงาน":  true,
-		"การเงิน": true,
-		"ความรัก": true,
-		"สุขภาพ":  true,
-	}
-
-	// Helper map to aggregate unique keywords per category
-	categoryKeywords := make(map[string]map[string]bool)
-	categoryBadKeywords := make(map[string]map[string]bool)
-	for cat := range counts {
-		categoryKeywords[cat] = make(map[string]bool)
-		categoryBadKeywords[cat] = make(map[string]bool)
-	}
-
-	updateBreakdown := func(pairNumber string) {
-		// Get categories directly from cache
-		if cats, ok := h.numberCategoryCache.GetCategories(pairNumber); ok {
-			keywords := h.numberCategoryCache.GetKeywords(pairNumber)
-
-			// Determine Good/Bad status from the SOURCE of Truth (PairCache - same as score)
-			isGood := false
-			isBad := false
-			if m, ok := h.numberPairCache.GetMeaning(pairNumber); ok {
-				if service.IsGoodPairType(m.PairType) {
-					isGood = true
-				} else if service.IsBadPairType(m.PairType) {
-					isBad = true
-				}
-			} else {
-				// Fallback to category cache if pair meaning missing (unlikely)
-				numberType := h.numberCategoryCache.GetNumberType(pairNumber)
-				isGood = (numberType == "ดี")
-				isBad = (numberType == "ร้าย")
-			}
-
-			for _, c := range cats {
-				if !allowedCategories[c] {
-					continue
-				}
-
-				counts[c]++ // Update count
-
-				current := breakdown[c]
-				if isGood {
-					current.Good++
-				} else if isBad {
-					current.Bad++
-				}
-
-				// Aggregate keywords based on good/bad status
-				for _, kw := range keywords {
-					if kw != "" {
-						if isGood {
-							categoryKeywords[c][kw] = true
-						} else if isBad {
-							categoryBadKeywords[c][kw] = true
-						}
-					}
-				}
-
-				breakdown[c] = current
-			}
-		}
-	}
-
-	// Process numerology pairs
-	for _, pairNumber := range numerologyPairs {
-		updateBreakdown(pairNumber)
-	}
-
-	// Process shadow pairs
-	for _, pairNumber := range shadowPairs {
-		updateBreakdown(pairNumber)
-	}
-
-	// Consolidate keywords into breakdown struct
-	for cat, kwMap := range categoryKeywords {
-		if entry, exists := breakdown[cat]; exists {
-			var kws []string
-			for kw := range kwMap {
-				kws = append(kws, kw)
-			}
-			sort.Strings(kws)
-			entry.Keywords = kws
-
-			// Add bad keywords
-			var badKws []string
-			for kw := range categoryBadKeywords[cat] {
-				badKws = append(badKws, kw)
-			}
-			sort.Strings(badKws)
-			entry.BadKeywords = badKws
-
-			// FIX: Combine Good + Bad keywords into main 'Keywords' field
-			// so that Mobile App (which reads only 'keywords') displays them.
-			// The Mobile App handles coloring based on 'HasBad' flag.
-			combinedKws := make([]string, 0, len(kws)+len(badKws))
-			combinedKws = append(combinedKws, kws...)
-			combinedKws = append(combinedKws, badKws...)
-			entry.Keywords = combinedKws
-
-			breakdown[cat] = entry
-		}
-	}
-
 	// Calculate Total Score % (Base Category Percentage logic)
 	totalBasePercent := 0.0
-	for _, cat := range []string{"สุขภาพ", "การงาน", "การเงิน", "ความรัก"} {
+	for _, cat := range numerology.Categories {
 		if bd, ok := breakdown[cat]; ok && bd.Good > 0 {
 			totalBasePercent += 25.0
 		}
@@ -1635,8 +1787,7 @@ func (h *NumerologyHandler) getSolarSystemProps(name, day string, repoAllowKlaki
 	}
 
 	// Unified Logic: Show all relevant categories separately matching Pie Chart colors
-	order := []string{"สุขภาพ", "การงาน", "การเงิน", "ความรัก"}
-	for _, cat := range order {
+	for _, cat := range numerology.Categories {
 		bd := breakdown[cat]
 		content := toDisplayKeywords(bd.Keywords, bd.BadKeywords)
 
@@ -1682,7 +1833,7 @@ func (h *NumerologyHandler) getSolarSystemProps(name, day string, repoAllowKlaki
 		InputDay:             service.GetThaiDay(day),
 		InputDayRaw:          day,
 		DisableKlakini:       !repoAllowKlakini,
-		SunDisplayNameHTML:   h.createDisplayChars(name, day),
+		SunDisplayNameHTML:   result.DisplayChars,
 		NumerologyPairs:      numMeanings,
 		ShadowPairs:          shaMeanings,
 		NumPositiveScore:     numPos,
@@ -1694,11 +1845,11 @@ func (h *NumerologyHandler) getSolarSystemProps(name, day string, repoAllowKlaki
 		TotalPairs:           len(numMeanings) + len(shaMeanings),
 		IsSunDead:            grandTotalScore < 0,
 		AllUniquePairs:       allUniquePairs,
-		DecodedParts:         results,
-		TotalNumerologyValue: totalNumerologyValue,
-		TotalShadowValue:     totalShadowValue,
+		DecodedParts:         result.Chars,
+		TotalNumerologyValue: result.SatTotal,
+		TotalShadowValue:     result.ShaTotal,
 		IsVIP:                isVIP,
-		CategoryCounts:       counts,
+		CategoryCounts:       result.CategoryCounts,
 		CategoryBreakdown:    breakdown,
 		AnalysisSummaries:    analysisSummaries,
 		ResultTitle:          resultTitle,
@@ -1718,13 +1869,8 @@ func (h *NumerologyHandler) AnalyzeAPIStreaming(c *fiber.Ctx) error {
 	c.Set("X-No-Compression", "yes")      // Hint for proxies
 
 	name := service.SanitizeInput(c.Query("name"))
-	day := strings.ToLower(strings.TrimSpace(c.Query("day")))
-	if day == "" {
-		day = strings.ToLower(strings.TrimSpace(c.Query("birth_day")))
-	}
-	if day == "" {
-		day = "thursday"
-	}
+	surname := service.SanitizeInput(c.Query("surname"))
+	day, birth, birthErr := birthDayParam(c)
 	isAuspicious := c.Query("auspicious") == "true" || c.Query("auspicious") == "on" || c.Query("auspicious") == "1" ||
 		c.Query("is_auspicious") == "true" || c.Query("is_auspicious") == "on" || c.Query("is_auspicious") == "1"
 	disableKlakini := c.Query("disable_klakini") == "true" || c.Query("disable_klakini") == "on" || c.Query("disable_klakini") == "1"
@@ -1738,6 +1884,7 @@ func (h *NumerologyHandler) AnalyzeAPIStreaming(c *fiber.Ctx) error {
 	// Parse all params BEFORE entering the stream closure (Ctx is not concurrency safe)
 	disableKlakiniTop4 := c.Query("disable_klakini_top4") == "true" || c.Query("disable_klakini_top4") == "on" || c.Query("disable_klakini_top4") == "1"
 	section := c.Query("section")
+	search := nameSearchParams(c)
 
 	limit := 3
 	if isVIP || isAdmin {
@@ -1762,10 +1909,34 @@ func (h *NumerologyHandler) AnalyzeAPIStreaming(c *fiber.Ctx) error {
 
 	log.Printf("DEBUG STREAM: Name=%s, Day=%s, Vip=%v, Admin=%v, KlakiniOff=%v, Limit=%d", name, day, isVIP, isAdmin, disableKlakini, limit)
 
+	if birthErr != nil {
+		payload, _ := json.Marshal(fiber.Map{"type": "error", "message": birthErr.Error()})
+		c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+			fmt.Fprintf(w, "data: %s\n\n", payload)
+			w.Flush()
+		})
+		return nil
+	}
+	if err := h.validateName(name, surname); err != nil {
+		payload, _ := json.Marshal(fiber.Map{"type": "error", "message": err.Error(), "unmapped_chars": unmappedCharsResponse(err)["unmapped_chars"]})
+		c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+			fmt.Fprintf(w, "data: %s\n\n", payload)
+			w.Flush()
+		})
+		return nil
+	}
+
+	client, vip := searchClient(c)
+
 	// Stream Body
 	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		// Cancelled once the client is gone, so it leaves the search queue
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
 		flush := func() {
-			w.Flush()
+			if err := w.Flush(); err != nil {
+				cancel()
+			}
 		}
 
 		// Non-compressible Padding to bypass Gzip/Nginx buffering
@@ -1787,10 +1958,28 @@ func (h *NumerologyHandler) AnalyzeAPIStreaming(c *fiber.Ctx) error {
 		onProgress(0, 50)
 		flush()
 
-		// Params moved outside closure
+		// Wait for a search slot, telling the client its place in the queue
+		release, err := h.scheduler.Acquire(ctx, client, vip, func(position int) {
+			payload, _ := json.Marshal(fiber.Map{
+				"type":           "progress",
+				"count":          0,
+				"total":          0,
+				"queue_position": position,
+				"message":        fmt.Sprintf("คุณอยู่ในคิวลำดับที่ %d", position),
+			})
+			fmt.Fprintf(w, "data: %s\n\n", payload)
+			flush()
+		})
+		if err != nil {
+			payload, _ := json.Marshal(fiber.Map{"type": "error", "message": searchQueueMessage(err)})
+			fmt.Fprintf(w, "data: %s\n\n", payload)
+			flush()
+			return
+		}
+		defer release()
 
 		// Call fetchSimilarNames with Progress Callback
-		similarNames, err := h.fetchSimilarNames(name, day, isAuspicious, repoAllowKlakini, limit, onProgress)
+		similarNames, err := h.fetchSimilarNames(name, day, search, isAuspicious, repoAllowKlakini, limit, onProgress)
 
 		log.Printf("DEBUG STREAM: fetchSimilarNames returned %d items, err=%v", len(similarNames), err)
 
@@ -1827,6 +2016,7 @@ func (h *NumerologyHandler) AnalyzeAPIStreaming(c *fiber.Ctx) error {
 			if len(similarNames) > 0 && similarNames[0].DisplayNameHTML == nil {
 				h.calculateScoresAndHighlights(similarNames, day)
 			}
+			similarNames = h.rankWithSurname(similarNames, surname, day, isAuspicious)
 		}
 		log.Printf("DEBUG STREAM: After check similarNames count = %d", len(similarNames))
 
@@ -1861,6 +2051,7 @@ func (h *NumerologyHandler) AnalyzeAPIStreaming(c *fiber.Ctx) error {
 					"sha_num":           n.ShaNum,
 					"t_sat":             n.TSat,
 					"t_sha":             n.TSha,
+					"combined":          n.Combined,
 				})
 			}
 			return result
@@ -1880,14 +2071,14 @@ func (h *NumerologyHandler) AnalyzeAPIStreaming(c *fiber.Ctx) error {
 		} else {
 			// Normal mode: Fetch specifically for "Best" section using quality-based filtering in SQL
 			var errBest error
-			bestCandidates, errBest = h.namesMiracleRepo.GetBestSimilarNames(name, day, limit, allowKlakiniTop4)
+			bestCandidates, errBest = h.bestSimilarNames(name, day, limit, allowKlakiniTop4)
 			if errBest == nil && len(bestCandidates) > 0 {
-				h.calculateScoresAndHighlights(bestCandidates, day)
+				bestCandidates = h.rankWithSurname(bestCandidates, surname, day, true)
 			} else {
 				// Final backup: try to salvage from similarNames even in normal mode
 				bestCandidates = []domain.SimilarNameResult{}
 				for _, n := range similarNames {
-					if !n.HasBadPair {
+					if !n.HasBadPair && (n.Combined == nil || !n.Combined.HasBadPair) {
 						if !allowKlakiniTop4 && len(n.KlakiniChars) > 0 {
 							continue
 						}
@@ -1940,6 +2131,8 @@ func (h *NumerologyHandler) AnalyzeAPIStreaming(c *fiber.Ctx) error {
 			"is_vip":               isVIP,
 			"is_admin":             isAdmin,
 			"total_filtered_count": totalDisplayCount,
+			"ruleset_version":      h.engine.Ruleset().Version,
+			"taksa":                h.engine.Taksa(name, day),
 			"best_names": fiber.Map{
 				"target_name_html":         displayNameHTML,
 				"total_count":              totalDisplayCount,
@@ -1953,6 +2146,12 @@ func (h *NumerologyHandler) AnalyzeAPIStreaming(c *fiber.Ctx) error {
 		if section == "" {
 			resp["solar_system"] = solarProps
 		}
+		if birth != nil {
+			resp["birth"] = birth
+		}
+		if surname != "" {
+			resp["full_name"] = h.engine.AnalyzeFullName(name, surname, day, numerology.Options{AllDays: true})
+		}
 
 		// Send FINAL RESULT safely
 		jsonBytes, errJson := json.Marshal(resp)
@@ -1972,7 +2171,7 @@ func (h *NumerologyHandler) AnalyzeAPIStreaming(c *fiber.Ctx) error {
 }
 
 func (h *NumerologyHandler) DebugRepo(c *fiber.Ctx) error {
-	res, err := h.namesMiracleRepo.GetSimilarNames("หมวย", "monday", 10, 0, true)
+	res, err := h.namesMiracleRepo.GetSimilarNames("หมวย", "monday", 10, 0, true, domain.NameSearchOptions{})
 	if err != nil {
 		return c.JSON(fiber.Map{"error": err.Error()})
 	}
@@ -1,32 +1,31 @@
 package handler
 
 import (
-	"numberniceic/internal/adapters/cache"
 	"numberniceic/internal/adapters/handler/templ_render"
 	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/numerology"
 	"numberniceic/internal/core/service"
 	"numberniceic/views/pages"
 	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/session"
 )
 
 type SavedNameHandler struct {
-	service         *service.SavedNameService
-	klakiniCache    *cache.KlakiniCache
-	numberPairCache *cache.NumberPairCache
-	store           *session.Store
+	service *service.SavedNameService
+	engine  *numerology.Engine
+	store   *session.Store
 }
 
-func NewSavedNameHandler(service *service.SavedNameService, klakiniCache *cache.KlakiniCache, numberPairCache *cache.NumberPairCache, store *session.Store) *SavedNameHandler {
+func NewSavedNameHandler(service *service.SavedNameService, engine *numerology.Engine, store *session.Store) *SavedNameHandler {
 	return &SavedNameHandler{
-		service:         service,
-		klakiniCache:    klakiniCache,
-		numberPairCache: numberPairCache,
-		store:           store,
+		service: service,
+		engine:  engine,
+		store:   store,
 	}
 }
 
@@ -48,37 +47,56 @@ func (h *SavedNameHandler) SaveName(c *fiber.Ctx) error {
 	}
 
 	// Parse form data or JSON
-	var name, birthDay string
+	var name, surname, birthDay string
+	var birthDate, birthTime, dayBoundary string
 	var totalScore, satSum, shaSum int
 
 	if strings.HasPrefix(c.Get("Content-Type"), "application/json") {
 		// JSON request (mobile app)
 		type SaveNameRequest struct {
-			Name       string `json:"name"`
-			BirthDay   string `json:"birth_day"`
-			TotalScore int    `json:"total_score"`
-			SatSum     int    `json:"sat_sum"`
-			ShaSum     int    `json:"sha_sum"`
+			Name     string `json:"name"`
+			Surname  string `json:"surname"`
+			BirthDay string `json:"birth_day"`
+			// Optional birth moment; when set it decides the birth day
+			BirthDate   string `json:"birth_date"`
+			BirthTime   string `json:"birth_time"`
+			DayBoundary string `json:"day_boundary"`
+			TotalScore  int    `json:"total_score"`
+			SatSum      int    `json:"sat_sum"`
+			ShaSum      int    `json:"sha_sum"`
 		}
 		var req SaveNameRequest
 		if err := c.BodyParser(&req); err != nil {
 			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request"})
 		}
 		name = req.Name
+		surname = req.Surname
 		birthDay = req.BirthDay
+		birthDate, birthTime, dayBoundary = req.BirthDate, req.BirthTime, req.DayBoundary
 		totalScore = req.TotalScore
 		satSum = req.SatSum
 		shaSum = req.ShaSum
 	} else {
 		// Form data (web)
 		name = c.FormValue("name")
+		surname = c.FormValue("surname")
 		birthDay = c.FormValue("birth_day")
+		birthDate, birthTime, dayBoundary = c.FormValue("birth_date"), c.FormValue("birth_time"), c.FormValue("day_boundary")
 		totalScore, _ = strconv.Atoi(c.FormValue("total_score"))
 		satSum, _ = strconv.Atoi(c.FormValue("sat_sum"))
 		shaSum, _ = strconv.Atoi(c.FormValue("sha_sum"))
 	}
 
-	err := h.service.SaveName(userID, name, birthDay, totalScore, satSum, shaSum)
+	var moment *domain.BirthMoment
+	if strings.TrimSpace(birthDate) != "" {
+		m, err := domain.ParseBirthMoment(birthDate, birthTime, dayBoundary)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		moment = m
+	}
+
+	err := h.service.SaveName(userID, strings.TrimSpace(name), strings.TrimSpace(surname), birthDay, moment, totalScore, satSum, shaSum, h.engine.Ruleset().Version)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Could not save name"})
 	}
@@ -104,128 +122,49 @@ func (h *SavedNameHandler) GetSavedNames(c *fiber.Ctx) error {
 }
 
 func (h *SavedNameHandler) prepareDisplayNames(savedNames []domain.SavedName) []domain.SavedNameDisplay {
+	return prepareSavedNameDisplays(h.engine, savedNames)
+}
+
+// prepareSavedNameDisplays scores saved names from their stored sums with the shared engine,
+// so the dashboard and the saved-names page read exactly like the analyzer.
+func prepareSavedNameDisplays(engine *numerology.Engine, savedNames []domain.SavedName) []domain.SavedNameDisplay {
 	displayNames := make([]domain.SavedNameDisplay, len(savedNames))
 	for i, sn := range savedNames {
-		satPairs := h.getPairsWithColors(sn.SatSum)
-		shaPairs := h.getPairsWithColors(sn.ShaSum)
-
-		// Calculate IsTopTier (Backend Logic Update to match Frontend)
-		isStrictTopTier := h.isAllPairsTopTier(satPairs) && h.isAllPairsTopTier(shaPairs)
-		isHighScore := sn.TotalScore >= 50
-
-		// Check for REAL Kalakini (ignoring invisible chars/spaces)
-		hasRealKalakini := false
-		for _, r := range sn.Name {
-			if h.klakiniCache.IsKlakini(sn.BirthDay, r) {
-				if !unicode.IsSpace(r) && !unicode.IsControl(r) {
-					hasRealKalakini = true
-					break
-				}
-			}
-		}
-
-		isTopTier := !hasRealKalakini && (isStrictTopTier || isHighScore)
-
-		// Create DisplayNameHTML
-		var displayChars []domain.DisplayChar
-		runes := []rune(sn.Name)
-		for j := 0; j < len(runes); j++ {
-			r := runes[j]
-			char := string(r)
-			isBad := h.klakiniCache.IsKlakini(sn.BirthDay, r)
-
-			// Check if the next character is a combining mark
-			if j+1 < len(runes) && unicode.Is(unicode.Mn, runes[j+1]) {
-				combiningChar := runes[j+1]
-				isCombiningBad := h.klakiniCache.IsKlakini(sn.BirthDay, combiningChar)
-
-				// If the base is not bad, but the combining mark is
-				if !isBad && isCombiningBad {
-					// Add the base character as good
-					displayChars = append(displayChars, domain.DisplayChar{Char: char, IsBad: false})
-					// Add the combining mark as bad
-					displayChars = append(displayChars, domain.DisplayChar{Char: string(combiningChar), IsBad: true})
-					j++ // Skip the combining mark in the next iteration
-					continue
-				}
-			}
-
-			// Default behavior: add the character with its own klakini status
-			displayChars = append(displayChars, domain.DisplayChar{Char: char, IsBad: isBad})
-		}
+		result := engine.AnalyzeTotals(sn.Name, sn.BirthDay, sn.SatSum, sn.ShaSum, numerology.Options{})
 
 		displayNames[i] = domain.SavedNameDisplay{
 			SavedName:       sn,
 			BirthDayThai:    service.GetThaiDay(sn.BirthDay),
 			BirthDayRaw:     strings.ToUpper(sn.BirthDay),
-			KlakiniChars:    h.getKlakiniChars(sn.Name, sn.BirthDay),
-			SatPairs:        satPairs,
-			ShaPairs:        shaPairs,
-			DisplayNameHTML: displayChars,
-			IsTopTier:       isTopTier,
+			KlakiniChars:    result.KlakiniChars,
+			SatPairs:        result.SatPairInfos(),
+			ShaPairs:        result.ShaPairInfos(),
+			DisplayNameHTML: result.DisplayChars,
+			IsTopTier:       savedNameIsTopTier(sn, result),
 		}
-	}
-	return displayNames
-}
 
-func (h *SavedNameHandler) isAllPairsTopTier(pairs []domain.PairInfo) bool {
-	for _, p := range pairs {
-		if meaning, ok := h.numberPairCache.GetMeaning(p.Number); ok {
-			switch meaning.PairType {
-			case "D10", "D8", "D5":
-			default:
-				return false
-			}
-		} else {
-			return false
+		if sn.Surname != "" {
+			full := engine.WithSurname(result, sn.Surname, sn.BirthDay, numerology.Options{})
+			displayNames[i].SurnameSatPairs = full.Last.SatPairInfos()
+			displayNames[i].SurnameShaPairs = full.Last.ShaPairInfos()
+			displayNames[i].FullNameSatPairs = full.Combined.SatPairInfos()
+			displayNames[i].FullNameShaPairs = full.Combined.ShaPairInfos()
+			displayNames[i].FullNameScore = full.Combined.TotalScore
 		}
 	}
-	return true
-}
-
-func (h *SavedNameHandler) getKlakiniChars(name, day string) []string {
-	var klakiniChars []string
-	for _, r := range name {
-		if h.klakiniCache.IsKlakini(day, r) {
-			klakiniChars = append(klakiniChars, string(r))
-		}
-	}
-	return klakiniChars
+	return displayNames
 }
 
-func (h *SavedNameHandler) getPairsWithColors(sum int) []domain.PairInfo {
-	s := strconv.Itoa(sum)
-	var pairs []string
-	if sum < 0 {
-		// No pairs for negative sums
-	} else if len(s) < 2 {
-		pairs = append(pairs, "0"+s)
-	} else if len(s) == 2 {
-		pairs = append(pairs, s)
-	} else { // len(s) > 2
-		if len(s)%2 != 0 {
-			for i := 0; i < len(s)-1; i++ {
-				pairs = append(pairs, s[i:i+2])
-			}
-		} else {
-			for i := 0; i < len(s); i += 2 {
-				pairs = append(pairs, s[i:i+2])
-			}
-		}
-	}
-
-	var pairInfos []domain.PairInfo
-	for _, p := range pairs {
-		meaning, ok := h.numberPairCache.GetMeaning(p)
-		color := "#ccc" // Default color
-		pairType := ""
-		if ok {
-			color = meaning.Color
-			pairType = meaning.PairType
+// savedNameIsTopTier keeps the saved-names badge rule: no real klakini character,
+// and either every pair is top tier or the stored score is at least 50.
+func savedNameIsTopTier(sn domain.SavedName, result *domain.NameAnalysis) bool {
+	for _, ch := range result.KlakiniChars {
+		r, _ := utf8.DecodeRuneInString(ch)
+		if !unicode.IsSpace(r) && !unicode.IsControl(r) {
+			return false
 		}
-		pairInfos = append(pairInfos, domain.PairInfo{Number: p, Color: color, Type: pairType})
 	}
-	return pairInfos
+	return result.IsTopTier || sn.TotalScore >= 50
 }
 
 func (h *SavedNameHandler) DeleteSavedName(c *fiber.Ctx) error {
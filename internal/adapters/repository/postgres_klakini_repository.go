@@ -7,7 +7,7 @@ import (
 )
 
 type PostgresKlakiniRepository struct {
-	db *sql.DB
+	db dbtx
 }
 
 func NewPostgresKlakiniRepository(db *sql.DB) *PostgresKlakiniRepository {
@@ -52,3 +52,17 @@ func (r *PostgresKlakiniRepository) GetByDay(day string) (domain.Klakini, error)
 	k.Day = strings.ToLower(strings.TrimSpace(k.Day))
 	return k, nil
 }
+
+// Save sets the bad characters of k.Day, inserting the day when it has no row.
+func (r *PostgresKlakiniRepository) Save(k domain.Klakini) error {
+	day := strings.ToLower(strings.TrimSpace(k.Day))
+	res, err := r.db.Exec("UPDATE public.kakis_day SET kakis = $2 WHERE lower(trim(day)) = $1", day, k.BadChars)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		return nil
+	}
+	_, err = r.db.Exec("INSERT INTO public.kakis_day (day, kakis) VALUES ($1, $2)", day, k.BadChars)
+	return err
+}
@@ -43,13 +43,16 @@ func (r *PostgresMemberRepository) GetByEmail(email string) (*domain.Member, err
 		return nil, nil
 	}
 	query := `
-		SELECT id, username, email, tel, status, day_of_birth, COALESCE(assigned_colors, ''), COALESCE(provider, ''), COALESCE(provider_id, ''), COALESCE(avatar_url, ''), vip_expires_at, wallet_colors_notified_at
+		SELECT id, username, email, tel, status, day_of_birth, COALESCE(assigned_colors, ''), COALESCE(provider, ''), COALESCE(provider_id, ''), COALESCE(avatar_url, ''), vip_expires_at, wallet_colors_notified_at,
+			birth_date, COALESCE(to_char(birth_time, 'HH24:MI'), ''), COALESCE(birth_day_boundary, '')
 		FROM member
 		WHERE email = $1
 	`
 	var m domain.Member
 	var provider, providerID, avatarURL sql.NullString
-	err := r.db.QueryRow(query, email).Scan(&m.ID, &m.Username, &m.Email, &m.Tel, &m.Status, &m.DayOfBirth, &m.AssignedColors, &provider, &providerID, &avatarURL, &m.VIPExpiresAt, &m.WalletColorsNotifiedAt)
+	var birthDate sql.NullTime
+	var birthTime, birthBoundary string
+	err := r.db.QueryRow(query, email).Scan(&m.ID, &m.Username, &m.Email, &m.Tel, &m.Status, &m.DayOfBirth, &m.AssignedColors, &provider, &providerID, &avatarURL, &m.VIPExpiresAt, &m.WalletColorsNotifiedAt, &birthDate, &birthTime, &birthBoundary)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -59,6 +62,7 @@ func (r *PostgresMemberRepository) GetByEmail(email string) (*domain.Member, err
 	m.Provider = provider.String
 	m.ProviderID = providerID.String
 	m.AvatarURL = avatarURL.String
+	m.BirthMoment = birthMomentFromColumns(birthDate, birthTime, birthBoundary)
 	m.Username = strings.TrimSpace(m.Username)
 	m.Email = strings.TrimSpace(m.Email)
 	m.Tel = strings.TrimSpace(m.Tel)
@@ -68,13 +72,16 @@ func (r *PostgresMemberRepository) GetByEmail(email string) (*domain.Member, err
 func (r *PostgresMemberRepository) GetByUsername(username string) (*domain.Member, error) {
 	// Use 'status' but skip timestamps for now
 	query := `
-		SELECT id, username, email, tel, status, day_of_birth, COALESCE(assigned_colors, ''), COALESCE(provider, ''), COALESCE(provider_id, ''), COALESCE(avatar_url, ''), vip_expires_at, wallet_colors_notified_at
+		SELECT id, username, email, tel, status, day_of_birth, COALESCE(assigned_colors, ''), COALESCE(provider, ''), COALESCE(provider_id, ''), COALESCE(avatar_url, ''), vip_expires_at, wallet_colors_notified_at,
+			birth_date, COALESCE(to_char(birth_time, 'HH24:MI'), ''), COALESCE(birth_day_boundary, '')
 		FROM member
 		WHERE username = $1
 	`
 	var m domain.Member
 	var provider, providerID, avatarURL sql.NullString
-	err := r.db.QueryRow(query, username).Scan(&m.ID, &m.Username, &m.Email, &m.Tel, &m.Status, &m.DayOfBirth, &m.AssignedColors, &provider, &providerID, &avatarURL, &m.VIPExpiresAt, &m.WalletColorsNotifiedAt)
+	var birthDate sql.NullTime
+	var birthTime, birthBoundary string
+	err := r.db.QueryRow(query, username).Scan(&m.ID, &m.Username, &m.Email, &m.Tel, &m.Status, &m.DayOfBirth, &m.AssignedColors, &provider, &providerID, &avatarURL, &m.VIPExpiresAt, &m.WalletColorsNotifiedAt, &birthDate, &birthTime, &birthBoundary)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -84,6 +91,7 @@ func (r *PostgresMemberRepository) GetByUsername(username string) (*domain.Membe
 	m.Provider = provider.String
 	m.ProviderID = providerID.String
 	m.AvatarURL = avatarURL.String
+	m.BirthMoment = birthMomentFromColumns(birthDate, birthTime, birthBoundary)
 	m.Username = strings.TrimSpace(m.Username)
 	m.Email = strings.TrimSpace(m.Email)
 	m.Tel = strings.TrimSpace(m.Tel)
@@ -93,13 +101,16 @@ func (r *PostgresMemberRepository) GetByUsername(username string) (*domain.Membe
 func (r *PostgresMemberRepository) GetByID(id int) (*domain.Member, error) {
 	// Use 'status' but skip timestamps for now
 	query := `
-		SELECT id, username, email, tel, status, day_of_birth, COALESCE(assigned_colors, ''), COALESCE(provider, ''), COALESCE(provider_id, ''), COALESCE(avatar_url, ''), vip_expires_at, wallet_colors_notified_at
+		SELECT id, username, email, tel, status, day_of_birth, COALESCE(assigned_colors, ''), COALESCE(provider, ''), COALESCE(provider_id, ''), COALESCE(avatar_url, ''), vip_expires_at, wallet_colors_notified_at,
+			birth_date, COALESCE(to_char(birth_time, 'HH24:MI'), ''), COALESCE(birth_day_boundary, '')
 		FROM member
 		WHERE id = $1
 	`
 	var m domain.Member
 	var provider, providerID, avatarURL sql.NullString
-	err := r.db.QueryRow(query, id).Scan(&m.ID, &m.Username, &m.Email, &m.Tel, &m.Status, &m.DayOfBirth, &m.AssignedColors, &provider, &providerID, &avatarURL, &m.VIPExpiresAt, &m.WalletColorsNotifiedAt)
+	var birthDate sql.NullTime
+	var birthTime, birthBoundary string
+	err := r.db.QueryRow(query, id).Scan(&m.ID, &m.Username, &m.Email, &m.Tel, &m.Status, &m.DayOfBirth, &m.AssignedColors, &provider, &providerID, &avatarURL, &m.VIPExpiresAt, &m.WalletColorsNotifiedAt, &birthDate, &birthTime, &birthBoundary)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -109,6 +120,7 @@ func (r *PostgresMemberRepository) GetByID(id int) (*domain.Member, error) {
 	m.Provider = provider.String
 	m.ProviderID = providerID.String
 	m.AvatarURL = avatarURL.String
+	m.BirthMoment = birthMomentFromColumns(birthDate, birthTime, birthBoundary)
 	m.Username = strings.TrimSpace(m.Username)
 	m.Email = strings.TrimSpace(m.Email)
 	m.Tel = strings.TrimSpace(m.Tel)
@@ -233,6 +245,29 @@ func (r *PostgresMemberRepository) UpdateDayOfBirth(id int, dayOfWeek int) error
 	return err
 }
 
+// UpdateBirthMoment stores the birth date and time and keeps day_of_birth in step with it.
+func (r *PostgresMemberRepository) UpdateBirthMoment(id int, moment *domain.BirthMoment) error {
+	var birthTime interface{}
+	if moment.HasTime {
+		birthTime = moment.Clock()
+	}
+	query := `UPDATE member SET birth_date = $1, birth_time = $2, birth_day_boundary = $3, day_of_birth = $4 WHERE id = $5`
+	_, err := r.db.Exec(query, moment.Date(), birthTime, moment.Boundary, moment.Weekday(), id)
+	return err
+}
+
+// birthMomentFromColumns rebuilds a birth moment from the member or saved_names columns.
+func birthMomentFromColumns(date sql.NullTime, clock, boundary string) *domain.BirthMoment {
+	if !date.Valid {
+		return nil
+	}
+	m, err := domain.ParseBirthMoment(date.Time.Format("2006-01-02"), clock, boundary)
+	if err != nil {
+		return nil
+	}
+	return m
+}
+
 func (r *PostgresMemberRepository) UpdateAssignedColors(id int, colors string) error {
 	query := `UPDATE member SET assigned_colors = $1 WHERE id = $2`
 	_, err := r.db.Exec(query, colors, id)
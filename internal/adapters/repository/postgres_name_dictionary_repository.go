@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/ports"
+	"strings"
+)
+
+type PostgresNameDictionaryRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresNameDictionaryRepository(db *sql.DB) ports.NameDictionaryRepository {
+	return &PostgresNameDictionaryRepository{db: db}
+}
+
+const nameDictionaryColumns = `id, term, kind, origin, meaning, source, status, created_at, updated_at`
+
+func scanNameDictionaryEntry(row interface{ Scan(...interface{}) error }) (*domain.NameDictionaryEntry, error) {
+	var e domain.NameDictionaryEntry
+	if err := row.Scan(&e.ID, &e.Term, &e.Kind, &e.Origin, &e.Meaning, &e.Source, &e.Status, &e.CreatedAt, &e.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (r *PostgresNameDictionaryRepository) query(query string, args ...interface{}) ([]domain.NameDictionaryEntry, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []domain.NameDictionaryEntry
+	for rows.Next() {
+		e, err := scanNameDictionaryEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, *e)
+	}
+	return entries, rows.Err()
+}
+
+func (r *PostgresNameDictionaryRepository) GetAll() ([]domain.NameDictionaryEntry, error) {
+	return r.query(`SELECT ` + nameDictionaryColumns + ` FROM name_dictionary ORDER BY kind, term`)
+}
+
+func (r *PostgresNameDictionaryRepository) Search(query, status string, limit int) ([]domain.NameDictionaryEntry, error) {
+	filters := []string{"1=1"}
+	args := []interface{}{limit}
+	if q := strings.TrimSpace(query); q != "" {
+		args = append(args, "%"+q+"%")
+		filters = append(filters, fmt.Sprintf("(term ILIKE $%d OR meaning ILIKE $%d)", len(args), len(args)))
+	}
+	if status != "" {
+		args = append(args, status)
+		filters = append(filters, fmt.Sprintf("status = $%d", len(args)))
+	}
+	return r.query(`SELECT `+nameDictionaryColumns+` FROM name_dictionary
+		WHERE `+strings.Join(filters, " AND ")+`
+		ORDER BY (status = 'pending') DESC, updated_at DESC, term
+		LIMIT $1`, args...)
+}
+
+func (r *PostgresNameDictionaryRepository) GetByID(id int) (*domain.NameDictionaryEntry, error) {
+	e, err := scanNameDictionaryEntry(r.db.QueryRow(`SELECT `+nameDictionaryColumns+` FROM name_dictionary WHERE id = $1`, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return e, err
+}
+
+func (r *PostgresNameDictionaryRepository) Create(e *domain.NameDictionaryEntry) error {
+	return r.db.QueryRow(`
+		INSERT INTO name_dictionary (term, kind, origin, meaning, source, status)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at, updated_at`,
+		e.Term, e.Kind, e.Origin, e.Meaning, e.Source, e.Status,
+	).Scan(&e.ID, &e.CreatedAt, &e.UpdatedAt)
+}
+
+func (r *PostgresNameDictionaryRepository) Update(e *domain.NameDictionaryEntry) error {
+	res, err := r.db.Exec(`
+		UPDATE name_dictionary
+		SET term = $2, kind = $3, origin = $4, meaning = $5, source = $6, status = $7, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`,
+		e.ID, e.Term, e.Kind, e.Origin, e.Meaning, e.Source, e.Status,
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("dictionary entry %d not found", e.ID)
+	}
+	return nil
+}
+
+func (r *PostgresNameDictionaryRepository) Delete(id int) error {
+	_, err := r.db.Exec(`DELETE FROM name_dictionary WHERE id = $1`, id)
+	return err
+}
+
+func (r *PostgresNameDictionaryRepository) Upsert(e *domain.NameDictionaryEntry) error {
+	return r.db.QueryRow(`
+		INSERT INTO name_dictionary (term, kind, origin, meaning, source, status)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (term, kind) DO UPDATE
+		SET origin = EXCLUDED.origin, meaning = EXCLUDED.meaning, source = EXCLUDED.source,
+			status = EXCLUDED.status, updated_at = CURRENT_TIMESTAMP
+		RETURNING id, created_at, updated_at`,
+		e.Term, e.Kind, e.Origin, e.Meaning, e.Source, e.Status,
+	).Scan(&e.ID, &e.CreatedAt, &e.UpdatedAt)
+}
+
+func (r *PostgresNameDictionaryRepository) CreateIfMissing(e *domain.NameDictionaryEntry) (bool, error) {
+	err := r.db.QueryRow(`
+		INSERT INTO name_dictionary (term, kind, origin, meaning, source, status)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (term, kind) DO NOTHING
+		RETURNING id, created_at, updated_at`,
+		e.Term, e.Kind, e.Origin, e.Meaning, e.Source, e.Status,
+	).Scan(&e.ID, &e.CreatedAt, &e.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -2,20 +2,26 @@ package repository
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"log"
 	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/numerology"
+	"numberniceic/internal/core/ports"
 	"strings"
 
 	"github.com/lib/pq"
 )
 
 type PostgresNamesMiracleRepository struct {
-	db *sql.DB
+	db       *sql.DB
+	rulesets ports.ScoringRulesetProvider
 }
 
-func NewPostgresNamesMiracleRepository(db *sql.DB) *PostgresNamesMiracleRepository {
-	return &PostgresNamesMiracleRepository{db: db}
+// NewPostgresNamesMiracleRepository creates the repository. Pair type filters
+// (top tier, bad pairs) come from the active scoring ruleset.
+func NewPostgresNamesMiracleRepository(db *sql.DB, rulesets ports.ScoringRulesetProvider) *PostgresNamesMiracleRepository {
+	return &PostgresNamesMiracleRepository{db: db, rulesets: rulesets}
 }
 
 // getKlakiniColumn maps a day string to its corresponding database column name.
@@ -37,8 +43,57 @@ func getKlakiniColumn(day string) (string, error) {
 	return col, nil
 }
 
+// similarityExpr is the SQL similarity for rank. $1 is always the name; the
+// phonetic key of the name is bound to keyParam when rank needs it.
+func similarityExpr(rank string, keyParam int) string {
+	spelling := "similarity(thname, $1)"
+	phonetic := fmt.Sprintf("similarity(phonetic_key, $%d)", keyParam)
+	switch rank {
+	case domain.RankPhonetic:
+		return phonetic
+	case domain.RankBlend:
+		return fmt.Sprintf("(%g * %s + %g * %s)", 1-domain.PhoneticBlendWeight, spelling, domain.PhoneticBlendWeight, phonetic)
+	}
+	return spelling
+}
+
+// searchFilters appends the metadata filters of opts as SQL conditions, binding
+// their values after args.
+func searchFilters(opts domain.NameSearchOptions, args []interface{}) ([]string, []interface{}) {
+	var filters []string
+	bind := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	if opts.Gender != "" {
+		filters = append(filters, fmt.Sprintf("gender IN (%s, '%s')", bind(opts.Gender), domain.GenderUnisex))
+	}
+	if opts.Syllables > 0 {
+		filters = append(filters, "syllables = "+bind(opts.Syllables))
+	}
+	if opts.FirstLetter != "" {
+		filters = append(filters, "first_letter = "+bind(opts.FirstLetter))
+	}
+	if opts.Category != "" {
+		filters = append(filters, fmt.Sprintf("COALESCE((category_strengths->>%s)::int, 0) > 0", bind(opts.Category)))
+	}
+	return filters, args
+}
+
+// searchSort returns the ORDER BY terms that opts.Sort puts ahead of the similarity.
+func searchSort(opts domain.NameSearchOptions, args []interface{}) (string, []interface{}) {
+	switch opts.Sort {
+	case domain.SortCategory:
+		args = append(args, opts.Category)
+		return fmt.Sprintf("COALESCE((category_strengths->>$%d)::int, 0) DESC, ", len(args)), args
+	case domain.SortSyllables:
+		return "syllables ASC, ", args
+	}
+	return "", args
+}
+
 // GetSimilarNames fetches up to a given limit of similar names with an offset.
-func (r *PostgresNamesMiracleRepository) GetSimilarNames(name, day string, limit, offset int, allowKlakini bool) ([]domain.SimilarNameResult, error) {
+func (r *PostgresNamesMiracleRepository) GetSimilarNames(name, day string, limit, offset int, allowKlakini bool, opts domain.NameSearchOptions) ([]domain.SimilarNameResult, error) {
 	klakiniColumn, err := getKlakiniColumn(day)
 	if err != nil {
 		return nil, err
@@ -50,22 +105,36 @@ func (r *PostgresNamesMiracleRepository) GetSimilarNames(name, day string, limit
 		klakiniWhereClause = fmt.Sprintf("AND %s = false", klakiniColumn)
 	}
 
+	args := []interface{}{name, limit, offset}
+	if opts.UsesPhonetic() {
+		args = append(args, numerology.PhoneticKey(name))
+	}
+	sim := similarityExpr(opts.Rank, 4)
+	metadata, args := searchFilters(opts, args)
+	for _, f := range metadata {
+		klakiniWhereClause += " AND " + f
+	}
+	sortBy, args := searchSort(opts, args)
+
 	query := fmt.Sprintf(`
         WITH filtered_names AS (
             SELECT 
                 name_id,
                 thname,
                 satnum,
-                shanum
+                shanum,
+                phonetic_key,
+                syllables,
+                category_strengths
             FROM names_miracle
             WHERE 1=1 %s
         ),
         ranked_names AS (
             SELECT
                 *,
-                similarity(thname, $1) as sim
+                %s as sim
             FROM filtered_names
-            WHERE similarity(thname, $1) > 0.01 -- Keep this for the "similar" search
+            WHERE %s > 0.01 -- Keep this for the "similar" search
         )
         SELECT 
             name_id,
@@ -74,11 +143,11 @@ func (r *PostgresNamesMiracleRepository) GetSimilarNames(name, day string, limit
             shanum,
             sim -- Return the similarity score
         FROM ranked_names
-        ORDER BY (CASE WHEN thname = $1 THEN 1 ELSE 0 END) DESC, sim DESC, thname ASC
+        ORDER BY (CASE WHEN thname = $1 THEN 1 ELSE 0 END) DESC, %ssim DESC, thname ASC
         LIMIT $2 OFFSET $3;
-    `, klakiniWhereClause)
+    `, klakiniWhereClause, sim, sim, sortBy)
 
-	return r.executeNameQuery(query, name, limit, offset)
+	return r.executeNameQuery(query, args...)
 }
 
 // GetBestSimilarNames fetches highly similar names that are also "Top Tier" (Strictly Good Pairs).
@@ -95,12 +164,12 @@ func (r *PostgresNamesMiracleRepository) GetBestSimilarNames(name, day string, l
 		klakiniWhereClause = fmt.Sprintf("AND %s = false", klakiniColumn)
 	}
 
-	// Filter for Strict Good Pairs: t_sat and t_sha must ONLY contain D10, D8, D5
+	// Filter for Strict Good Pairs: t_sat and t_sha must ONLY contain the ruleset's top-tier types
 	// Using PostgreSQL array containment operator <@
 	// e.g. t_sat <@ ARRAY['D10', 'D8', 'D5']::text[]
 	strictGoodClause := `
-		AND t_sat <@ ARRAY['D10', 'D8', 'D5']::text[]
-		AND t_sha <@ ARRAY['D10', 'D8', 'D5']::text[]
+		AND t_sat <@ $3::text[]
+		AND t_sha <@ $3::text[]
 	`
 
 	query := fmt.Sprintf(`
@@ -119,11 +188,11 @@ func (r *PostgresNamesMiracleRepository) GetBestSimilarNames(name, day string, l
         LIMIT $2;
     `, klakiniWhereClause, strictGoodClause)
 
-	return r.executeNameQuery(query, name, limit)
+	return r.executeNameQuery(query, name, limit, pq.Array(r.rulesets.Active().Rules.TopTierTypes))
 }
 
 // GetAuspiciousNames fetches names for the auspicious search, which has different filtering rules.
-func (r *PostgresNamesMiracleRepository) GetAuspiciousNames(name, preferredConsonant, day string, limit, offset int, allowKlakini, findGoodOnly bool) ([]domain.SimilarNameResult, error) {
+func (r *PostgresNamesMiracleRepository) GetAuspiciousNames(name, preferredConsonant, day string, limit, offset int, allowKlakini, findGoodOnly bool, opts domain.NameSearchOptions) ([]domain.SimilarNameResult, error) {
 	klakiniColumn, err := getKlakiniColumn(day)
 	if err != nil {
 		return nil, err
@@ -135,16 +204,18 @@ func (r *PostgresNamesMiracleRepository) GetAuspiciousNames(name, preferredConso
 		filters = append(filters, fmt.Sprintf("%s = false", klakiniColumn))
 	}
 
-	if findGoodOnly {
-		// Only Good Pairs: t_sat and t_sha must NOT contain R10, R7, R5
-		filters = append(filters, "NOT (t_sat && ARRAY['R10', 'R7', 'R5']::text[])")
-		filters = append(filters, "NOT (t_sha && ARRAY['R10', 'R7', 'R5']::text[])")
-	}
-
-	orderBy := "ORDER BY sim DESC"
+	orderBy := "ORDER BY %ssim DESC"
 	args := []interface{}{name} // $1
 	paramCount := 1
 
+	if findGoodOnly {
+		// Only Good Pairs: t_sat and t_sha must NOT contain any pair type the ruleset classes as bad
+		args = append(args, pq.Array(r.rulesets.Active().BadTypes()))
+		paramCount++
+		filters = append(filters, fmt.Sprintf("NOT (t_sat && $%d::text[])", paramCount))
+		filters = append(filters, fmt.Sprintf("NOT (t_sha && $%d::text[])", paramCount))
+	}
+
 	if preferredConsonant != "" {
 		p1 := preferredConsonant + "%"
 		p2 := "เ" + preferredConsonant + "%"
@@ -166,11 +237,23 @@ func (r *PostgresNamesMiracleRepository) GetAuspiciousNames(name, preferredConso
 				WHEN thname LIKE $%d THEN 1
 				ELSE 0 
 			END
-		) DESC, sim DESC`, paramCount+1, paramCount+2, paramCount+3, paramCount+4, paramCount+5, paramCount+6)
+		) DESC, %%ssim DESC`, paramCount+1, paramCount+2, paramCount+3, paramCount+4, paramCount+5, paramCount+6)
 
 		paramCount += 6
 	}
 
+	if opts.UsesPhonetic() {
+		args = append(args, numerology.PhoneticKey(name))
+		paramCount++
+	}
+	sim := similarityExpr(opts.Rank, paramCount)
+
+	metadata, args := searchFilters(opts, args)
+	filters = append(filters, metadata...)
+	sortBy, args := searchSort(opts, args)
+	orderBy = fmt.Sprintf(orderBy, sortBy)
+	paramCount = len(args)
+
 	args = append(args, limit, offset)
 	limitIdx := paramCount + 1
 	offsetIdx := paramCount + 2
@@ -181,14 +264,17 @@ func (r *PostgresNamesMiracleRepository) GetAuspiciousNames(name, preferredConso
                 name_id,
                 thname,
                 satnum,
-                shanum
+                shanum,
+                phonetic_key,
+                syllables,
+                category_strengths
             FROM names_miracle
             WHERE %s
         ),
         ranked_names AS (
             SELECT
                 *,
-                similarity(thname, $1) as sim
+                %s as sim
             FROM filtered_names
         )
         SELECT 
@@ -200,7 +286,7 @@ func (r *PostgresNamesMiracleRepository) GetAuspiciousNames(name, preferredConso
         FROM ranked_names
         %s
         LIMIT $%d OFFSET $%d;
-    `, strings.Join(filters, " AND "), orderBy, limitIdx, offsetIdx)
+    `, strings.Join(filters, " AND "), sim, orderBy, limitIdx, offsetIdx)
 
 	return r.executeNameQuery(query, args...)
 }
@@ -384,25 +470,17 @@ func (r *PostgresNamesMiracleRepository) GetFallbackNames(name, preferredConsona
 }
 
 func (r *PostgresNamesMiracleRepository) Create(name *domain.SimilarNameResult) error {
-	// Prepare t_sat and t_sha strings for pq.Array
-	var tSat []string
-	for _, t := range name.TSat {
-		tSat = append(tSat, t.Type)
-	}
-	var tSha []string
-	for _, t := range name.TSha {
-		tSha = append(tSha, t.Type)
-	}
-
 	query := `
 		INSERT INTO names_miracle (
 			thname, satnum, shanum, 
 			k_sunday, k_monday, k_tuesday, k_wednesday1, k_wednesday2, k_thursday, k_friday, k_saturday,
-			t_sat, t_sha
+			t_sat, t_sha, phonetic_key,
+			gender, syllables, first_letter, category_strengths
 		) VALUES (
 			$1, $2, $3, 
 			$4, $5, $6, $7, $8, $9, $10, $11,
-			$12, $13
+			$12, $13, $14,
+			$15, $16, $17, $18
 		) RETURNING name_id
 	`
 	err := r.db.QueryRow(
@@ -418,57 +496,145 @@ func (r *PostgresNamesMiracleRepository) Create(name *domain.SimilarNameResult)
 		name.KThursday,
 		name.KFriday,
 		name.KSaturday,
-		pq.Array(tSat),
-		pq.Array(tSha),
+		pq.Array(pairTypeNames(name.TSat)),
+		pq.Array(pairTypeNames(name.TSha)),
+		name.PhoneticKey,
+		genderOrUnisex(name.Gender),
+		name.Syllables,
+		name.FirstLetter,
+		categoryStrengthsJSON(name.CategoryStrengths),
 	).Scan(&name.NameID)
 
 	return err
 }
 
-func (r *PostgresNamesMiracleRepository) GetLatest(limit int) ([]domain.SimilarNameResult, error) {
-	query := `
-		SELECT 
+const namesMiracleStoredColumns = `
 			name_id, thname, satnum, shanum,
 			k_sunday, k_monday, k_tuesday, k_wednesday1, k_wednesday2, k_thursday, k_friday, k_saturday,
-			t_sat, t_sha
+			t_sat, t_sha, phonetic_key,
+			gender, syllables, first_letter, category_strengths`
+
+func (r *PostgresNamesMiracleRepository) GetLatest(limit int) ([]domain.SimilarNameResult, error) {
+	query := `
+		SELECT ` + namesMiracleStoredColumns + `
 		FROM names_miracle
 		ORDER BY name_id DESC
 		LIMIT $1
 	`
-	rows, err := r.db.Query(query, limit)
+	return r.queryStoredNames(query, limit)
+}
+
+// GetBatch returns up to limit rows with name_id greater than afterID, in name_id order.
+func (r *PostgresNamesMiracleRepository) GetBatch(afterID, limit int) ([]domain.SimilarNameResult, error) {
+	query := `
+		SELECT ` + namesMiracleStoredColumns + `
+		FROM names_miracle
+		WHERE name_id > $1
+		ORDER BY name_id
+		LIMIT $2
+	`
+	return r.queryStoredNames(query, afterID, limit)
+}
+
+// UpdateComputed overwrites the precomputed columns of the row with name.NameID.
+func (r *PostgresNamesMiracleRepository) UpdateComputed(name *domain.SimilarNameResult) error {
+	query := `
+		UPDATE names_miracle SET
+			satnum = $2, shanum = $3,
+			k_sunday = $4, k_monday = $5, k_tuesday = $6, k_wednesday1 = $7, k_wednesday2 = $8, k_thursday = $9, k_friday = $10, k_saturday = $11,
+			t_sat = $12, t_sha = $13, phonetic_key = $14,
+			gender = $15, syllables = $16, first_letter = $17, category_strengths = $18
+		WHERE name_id = $1
+	`
+	_, err := r.db.Exec(
+		query,
+		name.NameID,
+		pq.Array(name.SatNum),
+		pq.Array(name.ShaNum),
+		name.KSunday,
+		name.KMonday,
+		name.KTuesday,
+		name.KWednesday1,
+		name.KWednesday2,
+		name.KThursday,
+		name.KFriday,
+		name.KSaturday,
+		pq.Array(pairTypeNames(name.TSat)),
+		pq.Array(pairTypeNames(name.TSha)),
+		name.PhoneticKey,
+		genderOrUnisex(name.Gender),
+		name.Syllables,
+		name.FirstLetter,
+		categoryStrengthsJSON(name.CategoryStrengths),
+	)
+	return err
+}
+
+func genderOrUnisex(gender string) string {
+	if gender == "" {
+		return domain.GenderUnisex
+	}
+	return gender
+}
+
+func categoryStrengthsJSON(strengths map[string]int) []byte {
+	if len(strengths) == 0 {
+		return []byte("{}")
+	}
+	data, _ := json.Marshal(strengths)
+	return data
+}
+
+func pairTypeNames(types []domain.PairTypeInfo) []string {
+	var names []string
+	for _, t := range types {
+		names = append(names, t.Type)
+	}
+	return names
+}
+
+// queryStoredNames scans rows selected with namesMiracleStoredColumns.
+func (r *PostgresNamesMiracleRepository) queryStoredNames(query string, args ...interface{}) ([]domain.SimilarNameResult, error) {
+	rows, err := r.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
+	rs := r.rulesets.Active()
 	var results []domain.SimilarNameResult
 	for rows.Next() {
 		var res domain.SimilarNameResult
 		var satNum, shaNum, tSat, tSha pq.StringArray
+		var strengths []byte
 		err := rows.Scan(
 			&res.NameID, &res.ThName, &satNum, &shaNum,
 			&res.KSunday, &res.KMonday, &res.KTuesday, &res.KWednesday1, &res.KWednesday2, &res.KThursday, &res.KFriday, &res.KSaturday,
-			&tSat, &tSha,
+			&tSat, &tSha, &res.PhoneticKey,
+			&res.Gender, &res.Syllables, &res.FirstLetter, &strengths,
 		)
 		if err != nil {
 			return nil, err
 		}
+		if err := json.Unmarshal(strengths, &res.CategoryStrengths); err != nil {
+			return nil, fmt.Errorf("category_strengths of name_id %d: %w", res.NameID, err)
+		}
 
 		res.SatNum = []string(satNum)
 		res.ShaNum = []string(shaNum)
 
 		res.TSat = make([]domain.PairTypeInfo, len(tSat))
 		for i, v := range tSat {
-			res.TSat[i] = domain.PairTypeInfo{Type: v}
+			res.TSat[i] = domain.PairTypeInfo{Type: v, Color: rs.PairColor(v)}
 		}
 		res.TSha = make([]domain.PairTypeInfo, len(tSha))
 		for i, v := range tSha {
-			res.TSha[i] = domain.PairTypeInfo{Type: v}
+			res.TSha[i] = domain.PairTypeInfo{Type: v, Color: rs.PairColor(v)}
 		}
 
 		results = append(results, res)
 	}
-	return results, nil
+	return results, rows.Err()
 }
 
 func (r *PostgresNamesMiracleRepository) Delete(id int) error {
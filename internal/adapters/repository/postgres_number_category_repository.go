@@ -9,15 +9,30 @@ import (
 )
 
 type PostgresNumberCategoryRepository struct {
-	db *sql.DB
+	db dbtx
 }
 
 func NewPostgresNumberCategoryRepository(db *sql.DB) *PostgresNumberCategoryRepository {
 	return &PostgresNumberCategoryRepository{db: db}
 }
 
+func scanNumberCategory(row interface{ Scan(...interface{}) error }) (domain.NumberCategory, error) {
+	var c domain.NumberCategory
+	var pairNumber, category, numberType sql.NullString
+
+	if err := row.Scan(&c.ID, &pairNumber, &category, &numberType, pq.Array(&c.Keywords)); err != nil {
+		return c, err
+	}
+
+	c.PairNumber = strings.TrimSpace(pairNumber.String)
+	c.Category = strings.TrimSpace(category.String)
+	c.NumberType = strings.TrimSpace(numberType.String)
+	// keywords are already scanned into c.Keywords by pq.Array
+	return c, nil
+}
+
 func (r *PostgresNumberCategoryRepository) GetAll() ([]domain.NumberCategory, error) {
-	rows, err := r.db.Query("SELECT pairnumber, category, number_type, keywords FROM public.number_categories")
+	rows, err := r.db.Query("SELECT id, pairnumber, category, number_type, keywords FROM public.number_categories ORDER BY pairnumber, id")
 	if err != nil {
 		return nil, err
 	}
@@ -25,18 +40,11 @@ func (r *PostgresNumberCategoryRepository) GetAll() ([]domain.NumberCategory, er
 
 	var categories []domain.NumberCategory
 	for rows.Next() {
-		var c domain.NumberCategory
-		var pairNumber, category, numberType sql.NullString
-
-		if err := rows.Scan(&pairNumber, &category, &numberType, pq.Array(&c.Keywords)); err != nil {
+		c, err := scanNumberCategory(rows)
+		if err != nil {
 			return nil, err
 		}
 
-		c.PairNumber = strings.TrimSpace(pairNumber.String)
-		c.Category = strings.TrimSpace(category.String)
-		c.NumberType = strings.TrimSpace(numberType.String)
-		// keywords are already scanned into c.Keywords by pq.Array
-
 		if c.PairNumber != "" && c.Category != "" {
 			categories = append(categories, c)
 		}
@@ -48,3 +56,42 @@ func (r *PostgresNumberCategoryRepository) GetAll() ([]domain.NumberCategory, er
 
 	return categories, nil
 }
+
+func (r *PostgresNumberCategoryRepository) GetByID(id int) (*domain.NumberCategory, error) {
+	c, err := scanNumberCategory(r.db.QueryRow("SELECT id, pairnumber, category, number_type, keywords FROM public.number_categories WHERE id = $1", id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *PostgresNumberCategoryRepository) Create(c *domain.NumberCategory) error {
+	return r.db.QueryRow(`
+		INSERT INTO public.number_categories (pairnumber, category, number_type, keywords)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`, c.PairNumber, c.Category, c.NumberType, pq.Array(c.Keywords)).Scan(&c.ID)
+}
+
+func (r *PostgresNumberCategoryRepository) Update(c *domain.NumberCategory) error {
+	res, err := r.db.Exec(`
+		UPDATE public.number_categories
+		SET pairnumber = $2, category = $3, number_type = $4, keywords = $5, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+	`, c.ID, c.PairNumber, c.Category, c.NumberType, pq.Array(c.Keywords))
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *PostgresNumberCategoryRepository) Delete(id int) error {
+	_, err := r.db.Exec("DELETE FROM public.number_categories WHERE id = $1", id)
+	return err
+}
@@ -9,7 +9,7 @@ import (
 )
 
 type PostgresNumberPairRepository struct {
-	db *sql.DB
+	db dbtx
 }
 
 func NewPostgresNumberPairRepository(db *sql.DB) *PostgresNumberPairRepository {
@@ -46,7 +46,6 @@ func (r *PostgresNumberPairRepository) GetAll() ([]domain.NumberPairMeaning, err
 		m.PairPoint = int(pairPoint.Int64)
 		m.Category = strings.TrimSpace(category.String)
 		m.Keywords = keywords
-		m.Color = getPairTypeColor(m.PairType) // Assign color here
 
 		meanings = append(meanings, m)
 	}
@@ -58,22 +57,69 @@ func (r *PostgresNumberPairRepository) GetAll() ([]domain.NumberPairMeaning, err
 	return meanings, nil
 }
 
-func getPairTypeColor(pairType string) string {
-	trimmedType := strings.TrimSpace(pairType)
-	switch trimmedType {
-	case "D10":
-		return "#2E7D32" // Dark Green
-	case "D8":
-		return "#43A047" // Green
-	case "D5":
-		return "#66BB6A" // Light Green
-	case "R10":
-		return "#C62828" // Dark Red
-	case "R7":
-		return "#E53935" // Red
-	case "R5":
-		return "#EF5350" // Light Red
-	default:
-		return "#9E9E9E" // Grey
+const numberPairColumns = `pairnumber, pairtype, miracledetail, miracledesc, pairpoint`
+
+func scanNumberPair(row interface{ Scan(...interface{}) error }) (*domain.NumberPairMeaning, error) {
+	var pairNumber, pairType, detail, desc sql.NullString
+	var point sql.NullInt64
+	if err := row.Scan(&pairNumber, &pairType, &detail, &desc, &point); err != nil {
+		return nil, err
+	}
+	return &domain.NumberPairMeaning{
+		PairNumber:    strings.TrimSpace(pairNumber.String),
+		PairType:      strings.TrimSpace(pairType.String),
+		MiracleDetail: strings.TrimSpace(detail.String),
+		MiracleDesc:   strings.TrimSpace(desc.String),
+		PairPoint:     int(point.Int64),
+	}, nil
+}
+
+func (r *PostgresNumberPairRepository) List() ([]domain.NumberPairMeaning, error) {
+	rows, err := r.db.Query(`SELECT ` + numberPairColumns + ` FROM public.numbers ORDER BY pairnumber`)
+	if err != nil {
+		return nil, err
 	}
+	defer rows.Close()
+
+	var pairs []domain.NumberPairMeaning
+	for rows.Next() {
+		m, err := scanNumberPair(rows)
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, *m)
+	}
+	return pairs, rows.Err()
+}
+
+func (r *PostgresNumberPairRepository) GetByPair(pairNumber string) (*domain.NumberPairMeaning, error) {
+	row := r.db.QueryRow(`SELECT `+numberPairColumns+` FROM public.numbers WHERE pairnumber = $1 LIMIT 1`, pairNumber)
+	m, err := scanNumberPair(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return m, err
+}
+
+func (r *PostgresNumberPairRepository) Save(m *domain.NumberPairMeaning) error {
+	res, err := r.db.Exec(`
+		UPDATE public.numbers SET pairtype = $2, miracledetail = $3, miracledesc = $4, pairpoint = $5
+		WHERE pairnumber = $1
+	`, m.PairNumber, m.PairType, m.MiracleDetail, m.MiracleDesc, m.PairPoint)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		return nil
+	}
+	_, err = r.db.Exec(`
+		INSERT INTO public.numbers (pairnumber, pairtype, miracledetail, miracledesc, pairpoint)
+		VALUES ($1, $2, $3, $4, $5)
+	`, m.PairNumber, m.PairType, m.MiracleDetail, m.MiracleDesc, m.PairPoint)
+	return err
+}
+
+func (r *PostgresNumberPairRepository) Delete(pairNumber string) error {
+	_, err := r.db.Exec(`DELETE FROM public.numbers WHERE pairnumber = $1`, pairNumber)
+	return err
 }
@@ -8,7 +8,7 @@ import (
 
 // PostgresNumerologyRepository is a generic adapter that fetches numerology data from a specified table.
 type PostgresNumerologyRepository struct {
-	db        *sql.DB
+	db        dbtx
 	tableName string
 }
 
@@ -48,12 +48,46 @@ func (r *PostgresNumerologyRepository) GetAll() ([]domain.Numerology, error) {
 	return numerologies, nil
 }
 
+// GetByChar returns the row of char, or nil when there is none.
+func (r *PostgresNumerologyRepository) GetByChar(char string) (*domain.Numerology, error) {
+	query := fmt.Sprintf("SELECT char_key, %s FROM public.%s WHERE char_key = $1", r.getValueColumnName(), r.tableName)
+	var n domain.Numerology
+	err := r.db.QueryRow(query, char).Scan(&n.Character, &n.Value)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+// Save sets the value of n.Character, inserting the row when there is none.
+func (r *PostgresNumerologyRepository) Save(n domain.Numerology) error {
+	column := r.getValueColumnName()
+	res, err := r.db.Exec(fmt.Sprintf("UPDATE public.%s SET %s = $2 WHERE char_key = $1", r.tableName, column), n.Character, n.Value)
+	if err != nil {
+		return err
+	}
+	if rows, _ := res.RowsAffected(); rows > 0 {
+		return nil
+	}
+	_, err = r.db.Exec(fmt.Sprintf("INSERT INTO public.%s (char_key, %s) VALUES ($1, $2)", r.tableName, column), n.Character, n.Value)
+	return err
+}
+
+func (r *PostgresNumerologyRepository) Delete(char string) error {
+	_, err := r.db.Exec(fmt.Sprintf("DELETE FROM public.%s WHERE char_key = $1", r.tableName), char)
+	return err
+}
+
 // getValueColumnName determines the value column name based on the table name.
 // This is a small helper to handle the schema difference (sat_value vs sha_value).
+// The Latin tables share the column names of their Thai counterparts.
 func (r *PostgresNumerologyRepository) getValueColumnName() string {
-	if r.tableName == "sat_nums" {
+	if r.tableName == "sat_nums" || r.tableName == "latin_sat_nums" {
 		return "sat_value"
-	} else if r.tableName == "sha_nums" {
+	} else if r.tableName == "sha_nums" || r.tableName == "latin_sha_nums" {
 		return "sha_value"
 	}
 	// Default fallback or error handling could go here
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"database/sql"
+	"numberniceic/internal/core/domain"
+)
+
+type PostgresReferenceDataChangeRepository struct {
+	db dbtx
+}
+
+func NewPostgresReferenceDataChangeRepository(db *sql.DB) *PostgresReferenceDataChangeRepository {
+	return &PostgresReferenceDataChangeRepository{db: db}
+}
+
+func (r *PostgresReferenceDataChangeRepository) Record(change *domain.ReferenceDataChange) error {
+	return r.db.QueryRow(`
+		INSERT INTO reference_data_changes (table_name, record_key, action, before_data, after_data, changed_by)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, 0))
+		RETURNING id, changed_at
+	`, change.TableName, change.RecordKey, change.Action, nullJSON(change.Before), nullJSON(change.After), change.ChangedBy).
+		Scan(&change.ID, &change.ChangedAt)
+}
+
+func (r *PostgresReferenceDataChangeRepository) List(tableName, recordKey string, limit int) ([]domain.ReferenceDataChange, error) {
+	rows, err := r.db.Query(`
+		SELECT id, table_name, record_key, action, before_data, after_data, COALESCE(changed_by, 0), changed_at
+		FROM reference_data_changes
+		WHERE table_name = $1 AND ($2 = '' OR record_key = $2)
+		ORDER BY changed_at DESC, id DESC
+		LIMIT $3
+	`, tableName, recordKey, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []domain.ReferenceDataChange
+	for rows.Next() {
+		var c domain.ReferenceDataChange
+		var before, after []byte
+		if err := rows.Scan(&c.ID, &c.TableName, &c.RecordKey, &c.Action, &before, &after, &c.ChangedBy, &c.ChangedAt); err != nil {
+			return nil, err
+		}
+		c.Before, c.After = before, after
+		changes = append(changes, c)
+	}
+	return changes, rows.Err()
+}
+
+// nullJSON stores an empty document as NULL rather than invalid JSON.
+func nullJSON(b []byte) interface{} {
+	if len(b) == 0 {
+		return nil
+	}
+	return string(b)
+}
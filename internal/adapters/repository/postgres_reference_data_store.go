@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"database/sql"
+	"numberniceic/internal/core/ports"
+)
+
+// dbtx is what a repository queries through: the pool or one transaction.
+type dbtx interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+type PostgresReferenceDataStore struct {
+	postgresReferenceDataTx
+	db *sql.DB
+}
+
+func NewPostgresReferenceDataStore(db *sql.DB) *PostgresReferenceDataStore {
+	return &PostgresReferenceDataStore{postgresReferenceDataTx: postgresReferenceDataTx{db: db}, db: db}
+}
+
+func (s *PostgresReferenceDataStore) InTx(fn func(tx ports.ReferenceDataTx) error) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(postgresReferenceDataTx{db: tx}); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+type postgresReferenceDataTx struct {
+	db dbtx
+}
+
+func (t postgresReferenceDataTx) Pairs() ports.NumberPairRepository {
+	return &PostgresNumberPairRepository{db: t.db}
+}
+
+func (t postgresReferenceDataTx) Categories() ports.NumberCategoryRepository {
+	return &PostgresNumberCategoryRepository{db: t.db}
+}
+
+func (t postgresReferenceDataTx) Changes() ports.ReferenceDataChangeRepository {
+	return &PostgresReferenceDataChangeRepository{db: t.db}
+}
+
+func (t postgresReferenceDataTx) Letters(table string) ports.NumerologyRepository {
+	return &PostgresNumerologyRepository{db: t.db, tableName: table}
+}
+
+func (t postgresReferenceDataTx) Klakini() ports.KlakiniRepository {
+	return &PostgresKlakiniRepository{db: t.db}
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"database/sql"
+	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/ports"
+	"time"
+)
+
+type PostgresSavedCoupleRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresSavedCoupleRepository(db *sql.DB) ports.SavedCoupleRepository {
+	return &PostgresSavedCoupleRepository{db: db}
+}
+
+func (r *PostgresSavedCoupleRepository) Save(couple *domain.SavedCouple) error {
+	query := `
+		INSERT INTO saved_couples (created_at, user_id, name1, surname1, day1, name2, surname2, day2, score, ruleset_version)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id
+	`
+	now := time.Now()
+	err := r.db.QueryRow(query, now, couple.UserID,
+		couple.First.Name, couple.First.Surname, couple.First.Day,
+		couple.Second.Name, couple.Second.Surname, couple.Second.Day,
+		couple.Score, couple.RulesetVersion).Scan(&couple.ID)
+	if err != nil {
+		return err
+	}
+	couple.CreatedAt = now
+	return nil
+}
+
+func (r *PostgresSavedCoupleRepository) GetByUserID(userID int) ([]domain.SavedCouple, error) {
+	query := `
+		SELECT id, created_at, user_id, name1, surname1, day1, name2, surname2, day2, score, ruleset_version
+		FROM saved_couples
+		WHERE user_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at DESC
+	`
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var couples []domain.SavedCouple
+	for rows.Next() {
+		var s domain.SavedCouple
+		err := rows.Scan(&s.ID, &s.CreatedAt, &s.UserID,
+			&s.First.Name, &s.First.Surname, &s.First.Day,
+			&s.Second.Name, &s.Second.Surname, &s.Second.Day,
+			&s.Score, &s.RulesetVersion)
+		if err != nil {
+			return nil, err
+		}
+		couples = append(couples, s)
+	}
+	return couples, rows.Err()
+}
+
+func (r *PostgresSavedCoupleRepository) Delete(id int, userID int) error {
+	query := `
+		UPDATE saved_couples
+		SET deleted_at = $1
+		WHERE id = $2 AND user_id = $3
+	`
+	_, err := r.db.Exec(query, time.Now(), id, userID)
+	return err
+}
@@ -17,12 +17,19 @@ func NewPostgresSavedNameRepository(db *sql.DB) ports.SavedNameRepository {
 
 func (r *PostgresSavedNameRepository) Save(savedName *domain.SavedName) error {
 	query := `
-		INSERT INTO saved_names (created_at, updated_at, user_id, name, birth_day, total_score, sat_sum, sha_sum)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO saved_names (created_at, updated_at, user_id, name, surname, birth_day, total_score, sat_sum, sha_sum, ruleset_version, birth_date, birth_time, birth_day_boundary)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 		RETURNING id
 	`
+	var birthDate, birthTime, birthBoundary interface{}
+	if m := savedName.BirthMoment; m != nil {
+		birthDate, birthBoundary = m.Date(), m.Boundary
+		if m.HasTime {
+			birthTime = m.Clock()
+		}
+	}
 	now := time.Now()
-	err := r.db.QueryRow(query, now, now, savedName.UserID, savedName.Name, savedName.BirthDay, savedName.TotalScore, savedName.SatSum, savedName.ShaSum).Scan(&savedName.ID)
+	err := r.db.QueryRow(query, now, now, savedName.UserID, savedName.Name, savedName.Surname, savedName.BirthDay, savedName.TotalScore, savedName.SatSum, savedName.ShaSum, savedName.RulesetVersion, birthDate, birthTime, birthBoundary).Scan(&savedName.ID)
 	if err != nil {
 		return err
 	}
@@ -32,13 +39,21 @@ func (r *PostgresSavedNameRepository) Save(savedName *domain.SavedName) error {
 }
 
 func (r *PostgresSavedNameRepository) GetByUserID(userID int) ([]domain.SavedName, error) {
+	return r.querySavedNames(`WHERE user_id = $1 AND deleted_at IS NULL ORDER BY created_at DESC`, userID)
+}
+
+// GetAll returns every saved name that has not been deleted, for consistency checks.
+func (r *PostgresSavedNameRepository) GetAll() ([]domain.SavedName, error) {
+	return r.querySavedNames(`WHERE deleted_at IS NULL ORDER BY id`)
+}
+
+func (r *PostgresSavedNameRepository) querySavedNames(where string, args ...interface{}) ([]domain.SavedName, error) {
 	query := `
-		SELECT id, created_at, updated_at, user_id, name, birth_day, total_score, sat_sum, sha_sum
+		SELECT id, created_at, updated_at, user_id, name, surname, birth_day, total_score, sat_sum, sha_sum, ruleset_version,
+			birth_date, COALESCE(to_char(birth_time, 'HH24:MI'), ''), COALESCE(birth_day_boundary, '')
 		FROM saved_names
-		WHERE user_id = $1 AND deleted_at IS NULL
-		ORDER BY created_at DESC
-	`
-	rows, err := r.db.Query(query, userID)
+		` + where
+	rows, err := r.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -47,10 +62,13 @@ func (r *PostgresSavedNameRepository) GetByUserID(userID int) ([]domain.SavedNam
 	var savedNames []domain.SavedName
 	for rows.Next() {
 		var s domain.SavedName
-		err := rows.Scan(&s.ID, &s.CreatedAt, &s.UpdatedAt, &s.UserID, &s.Name, &s.BirthDay, &s.TotalScore, &s.SatSum, &s.ShaSum)
+		var birthDate sql.NullTime
+		var birthTime, birthBoundary string
+		err := rows.Scan(&s.ID, &s.CreatedAt, &s.UpdatedAt, &s.UserID, &s.Name, &s.Surname, &s.BirthDay, &s.TotalScore, &s.SatSum, &s.ShaSum, &s.RulesetVersion, &birthDate, &birthTime, &birthBoundary)
 		if err != nil {
 			return nil, err
 		}
+		s.BirthMoment = birthMomentFromColumns(birthDate, birthTime, birthBoundary)
 		savedNames = append(savedNames, s)
 	}
 	return savedNames, nil
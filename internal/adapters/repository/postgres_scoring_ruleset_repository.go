@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/ports"
+)
+
+type PostgresScoringRulesetRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresScoringRulesetRepository(db *sql.DB) ports.ScoringRulesetRepository {
+	return &PostgresScoringRulesetRepository{db: db}
+}
+
+const scoringRulesetColumns = `id, version, name, rules, is_active, created_at, activated_at`
+
+func scanScoringRuleset(row interface{ Scan(...interface{}) error }) (*domain.ScoringRuleset, error) {
+	var rs domain.ScoringRuleset
+	var rules []byte
+	if err := row.Scan(&rs.ID, &rs.Version, &rs.Name, &rules, &rs.IsActive, &rs.CreatedAt, &rs.ActivatedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(rules, &rs.Rules); err != nil {
+		return nil, err
+	}
+	return &rs, nil
+}
+
+func (r *PostgresScoringRulesetRepository) GetActive() (*domain.ScoringRuleset, error) {
+	row := r.db.QueryRow(`SELECT ` + scoringRulesetColumns + ` FROM scoring_rulesets WHERE is_active = true ORDER BY version DESC LIMIT 1`)
+	rs, err := scanScoringRuleset(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return rs, err
+}
+
+func (r *PostgresScoringRulesetRepository) GetAll() ([]domain.ScoringRuleset, error) {
+	rows, err := r.db.Query(`SELECT ` + scoringRulesetColumns + ` FROM scoring_rulesets ORDER BY version DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rulesets []domain.ScoringRuleset
+	for rows.Next() {
+		rs, err := scanScoringRuleset(rows)
+		if err != nil {
+			return nil, err
+		}
+		rulesets = append(rulesets, *rs)
+	}
+	return rulesets, rows.Err()
+}
+
+// Create stores the ruleset as the next version. It is not activated.
+func (r *PostgresScoringRulesetRepository) Create(ruleset *domain.ScoringRuleset) error {
+	rules, err := json.Marshal(ruleset.Rules)
+	if err != nil {
+		return err
+	}
+	query := `
+		INSERT INTO scoring_rulesets (version, name, rules)
+		VALUES ((SELECT COALESCE(MAX(version), 0) + 1 FROM scoring_rulesets), $1, $2)
+		RETURNING id, version, created_at
+	`
+	return r.db.QueryRow(query, ruleset.Name, rules).Scan(&ruleset.ID, &ruleset.Version, &ruleset.CreatedAt)
+}
+
+// Activate makes version the only active ruleset.
+func (r *PostgresScoringRulesetRepository) Activate(version int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(`UPDATE scoring_rulesets SET is_active = true, activated_at = NOW() WHERE version = $1`, version)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return sql.ErrNoRows
+	}
+	if _, err := tx.Exec(`UPDATE scoring_rulesets SET is_active = false WHERE version <> $1`, version); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
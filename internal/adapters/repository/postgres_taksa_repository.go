@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"database/sql"
+	"numberniceic/internal/core/domain"
+	"strings"
+)
+
+type PostgresTaksaRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresTaksaRepository(db *sql.DB) *PostgresTaksaRepository {
+	return &PostgresTaksaRepository{db: db}
+}
+
+func (r *PostgresTaksaRepository) GetAll() ([]domain.Taksa, error) {
+	rows, err := r.db.Query("SELECT day, taksa_group, chars FROM public.taksa_day")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var taksas []domain.Taksa
+	for rows.Next() {
+		var t domain.Taksa
+		if err := rows.Scan(&t.Day, &t.Group, &t.Chars); err != nil {
+			return nil, err
+		}
+		t.Day = strings.ToLower(strings.TrimSpace(t.Day))
+		t.Group = strings.TrimSpace(t.Group)
+		taksas = append(taksas, t)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return taksas, nil
+}
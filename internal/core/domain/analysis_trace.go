@@ -0,0 +1,43 @@
+package domain
+
+// AnalysisTrace explains step by step how a name's sums and pairs were produced.
+// It is returned by /api/analyze?explain=true for support staff.
+type AnalysisTrace struct {
+	Name     string         `json:"name"`
+	Clusters []ClusterTrace `json:"clusters"`
+	SatTotal int            `json:"sat_total"`
+	ShaTotal int            `json:"sha_total"`
+	SatSplit PairSplitTrace `json:"sat_split"`
+	ShaSplit PairSplitTrace `json:"sha_split"`
+	Missing  []string       `json:"missing"` // Components without a sat or sha value, counted as 0
+}
+
+// ClusterTrace is one ThaiChar cluster (or Latin letter) of the name.
+type ClusterTrace struct {
+	Original   string           `json:"original"`
+	Consonant  string           `json:"consonant,omitempty"`
+	Vowel      string           `json:"vowel,omitempty"`
+	ToneMark   string           `json:"tone_mark,omitempty"`
+	IsThai     bool             `json:"is_thai"`
+	IsLatin    bool             `json:"is_latin"`
+	Components []ComponentTrace `json:"components"`
+}
+
+// ComponentTrace is a single value lookup and the running totals after it.
+type ComponentTrace struct {
+	Character  string `json:"character"`
+	Role       string `json:"role"` // consonant, vowel, tone_mark or latin
+	SatValue   int    `json:"sat_value"`
+	ShaValue   int    `json:"sha_value"`
+	SatFound   bool   `json:"sat_found"`
+	ShaFound   bool   `json:"sha_found"`
+	SatRunning int    `json:"sat_running"`
+	ShaRunning int    `json:"sha_running"`
+}
+
+// PairSplitTrace shows how a total was cut into pairs.
+type PairSplitTrace struct {
+	Total  int      `json:"total"`
+	Method string   `json:"method"`
+	Pairs  []string `json:"pairs"`
+}
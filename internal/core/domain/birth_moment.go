@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Thai astrological birth days. Wednesday is split at 18:00 into daytime
+// (wednesday1) and night, the day of Rahu (wednesday2).
+const (
+	BirthDaySunday     = "sunday"
+	BirthDayMonday     = "monday"
+	BirthDayTuesday    = "tuesday"
+	BirthDayWednesday1 = "wednesday1"
+	BirthDayWednesday2 = "wednesday2"
+	BirthDayThursday   = "thursday"
+	BirthDayFriday     = "friday"
+	BirthDaySaturday   = "saturday"
+)
+
+// Where one astrological day ends and the next begins.
+const (
+	DayBoundaryMidnight = "midnight" // Civil day, as on the calendar
+	DayBoundarySunrise  = "sunrise"  // Traditional: the day starts at sunrise (06:00)
+)
+
+const (
+	birthDateLayout = "2006-01-02"
+	birthTimeLayout = "15:04"
+
+	wednesdayNightHour = 18
+	sunriseHour        = 6
+)
+
+// BangkokLocation is the zone birth moments are read in.
+var BangkokLocation = func() *time.Location {
+	loc, err := time.LoadLocation("Asia/Bangkok")
+	if err != nil {
+		return time.FixedZone("ICT", 7*60*60)
+	}
+	return loc
+}()
+
+var weekdayBirthDays = map[time.Weekday]string{
+	time.Sunday:    BirthDaySunday,
+	time.Monday:    BirthDayMonday,
+	time.Tuesday:   BirthDayTuesday,
+	time.Wednesday: BirthDayWednesday1,
+	time.Thursday:  BirthDayThursday,
+	time.Friday:    BirthDayFriday,
+	time.Saturday:  BirthDaySaturday,
+}
+
+// IsBirthDay reports whether day is one of the BirthDay constants.
+func IsBirthDay(day string) bool {
+	switch day {
+	case BirthDaySunday, BirthDayMonday, BirthDayTuesday, BirthDayWednesday1,
+		BirthDayWednesday2, BirthDayThursday, BirthDayFriday, BirthDaySaturday:
+		return true
+	}
+	return false
+}
+
+// BirthMoment is a birth date with an optional time of day, in Asia/Bangkok.
+type BirthMoment struct {
+	Time     time.Time // Midnight of the date when HasTime is false
+	HasTime  bool      // Without a time, Wednesday births count as daytime
+	Boundary string    // DayBoundaryMidnight or DayBoundarySunrise
+}
+
+// ParseBirthMoment reads a date (YYYY-MM-DD), an optional time (HH:MM) and an
+// optional day boundary. The date may also carry the time itself, as
+// "YYYY-MM-DDTHH:MM", "YYYY-MM-DD HH:MM" or RFC 3339.
+func ParseBirthMoment(date, clock, boundary string) (*BirthMoment, error) {
+	date = strings.TrimSpace(date)
+	clock = strings.TrimSpace(clock)
+	boundary = strings.ToLower(strings.TrimSpace(boundary))
+	if boundary == "" {
+		boundary = DayBoundaryMidnight
+	}
+	if boundary != DayBoundaryMidnight && boundary != DayBoundarySunrise {
+		return nil, fmt.Errorf("unknown day boundary %q", boundary)
+	}
+	if date == "" {
+		return nil, errors.New("birth date is required")
+	}
+
+	m := &BirthMoment{Boundary: boundary}
+	if t, err := time.Parse(time.RFC3339, date); err == nil {
+		m.Time = t.In(BangkokLocation)
+		m.HasTime = true
+		return m, nil
+	}
+	for _, layout := range []string{"2006-01-02T15:04", "2006-01-02 15:04", "2006-01-02T15:04:05", "2006-01-02 15:04:05"} {
+		if t, err := time.ParseInLocation(layout, date, BangkokLocation); err == nil {
+			m.Time = t
+			m.HasTime = true
+			return m, nil
+		}
+	}
+
+	d, err := time.ParseInLocation(birthDateLayout, date, BangkokLocation)
+	if err != nil {
+		return nil, fmt.Errorf("invalid birth date %q, expected YYYY-MM-DD", date)
+	}
+	m.Time = d
+	if clock != "" {
+		t, err := time.Parse(birthTimeLayout, clock)
+		if err != nil {
+			return nil, fmt.Errorf("invalid birth time %q, expected HH:MM", clock)
+		}
+		m.Time = time.Date(d.Year(), d.Month(), d.Day(), t.Hour(), t.Minute(), 0, 0, BangkokLocation)
+		m.HasTime = true
+	}
+	return m, nil
+}
+
+// Day is the Thai astrological birth day. Wednesday from 18:00 is Wednesday
+// night. With the sunrise boundary, births before 06:00 belong to the previous
+// day, so Thursday before sunrise is still Wednesday night.
+func (m *BirthMoment) Day() string {
+	t := m.Time.In(BangkokLocation)
+	if !m.HasTime {
+		return weekdayBirthDays[t.Weekday()]
+	}
+	if m.Boundary == DayBoundarySunrise && t.Hour() < sunriseHour {
+		t = t.AddDate(0, 0, -1)
+		if t.Weekday() == time.Wednesday {
+			return BirthDayWednesday2
+		}
+		return weekdayBirthDays[t.Weekday()]
+	}
+	if t.Weekday() == time.Wednesday && t.Hour() >= wednesdayNightHour {
+		return BirthDayWednesday2
+	}
+	return weekdayBirthDays[t.Weekday()]
+}
+
+// Weekday is the 0 (Sunday) to 6 (Saturday) index stored in member.day_of_birth,
+// using the same boundary as Day.
+func (m *BirthMoment) Weekday() int {
+	t := m.Time.In(BangkokLocation)
+	if m.HasTime && m.Boundary == DayBoundarySunrise && t.Hour() < sunriseHour {
+		t = t.AddDate(0, 0, -1)
+	}
+	return int(t.Weekday())
+}
+
+// Date is the birth date as YYYY-MM-DD.
+func (m *BirthMoment) Date() string {
+	return m.Time.In(BangkokLocation).Format(birthDateLayout)
+}
+
+// Clock is the birth time as HH:MM, or empty when unknown.
+func (m *BirthMoment) Clock() string {
+	if !m.HasTime {
+		return ""
+	}
+	return m.Time.In(BangkokLocation).Format(birthTimeLayout)
+}
+
+// MarshalJSON reports the moment as its date, time and the resolved day.
+func (m BirthMoment) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Date     string `json:"date"`
+		Time     string `json:"time,omitempty"`
+		Boundary string `json:"boundary"`
+		Day      string `json:"day"`
+	}{m.Date(), m.Clock(), m.Boundary, m.Day()})
+}
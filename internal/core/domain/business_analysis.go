@@ -0,0 +1,30 @@
+package domain
+
+// Positions a brand affix can take.
+const (
+	BrandAffixPrefix = "prefix"
+	BrandAffixSuffix = "suffix"
+)
+
+// BusinessFocus is the breakdown of one of the categories a business name is judged on.
+type BusinessFocus struct {
+	Category string `json:"category"`
+	CategoryBreakdown
+}
+
+// BrandSuggestion is a word added before or after a brand name that makes every
+// pair of the total a top-tier (D) pair.
+type BrandSuggestion struct {
+	Affix    string          `json:"affix"`
+	Position string          `json:"position"`
+	Pillar   *CombinedPillar `json:"pillar"` // Score of the brand name with the affix
+}
+
+// BusinessNameAnalysis scores a shop or brand name for the day it was registered
+// or opened. It replaces similar-name suggestions with brand affixes.
+type BusinessNameAnalysis struct {
+	Analysis    *NameAnalysis     `json:"analysis"`
+	Opening     *BirthMoment      `json:"opening,omitempty"` // Registration or opening date the day was derived from
+	Focus       []BusinessFocus   `json:"focus"`
+	Suggestions []BrandSuggestion `json:"suggestions"`
+}
@@ -0,0 +1,37 @@
+package domain
+
+// Causes of a stored analysis disagreeing with the live engine.
+const (
+	MismatchMissingMapping = "missing_mapping" // A character has no sat or sha value any more
+	MismatchValueChange    = "value_change"    // Letter values changed, so the sums differ
+	MismatchPairMeaning    = "pair_meaning"    // Same pairs, different number_pairs meaning or points
+	MismatchKlakini        = "klakini"         // The klakini letters of a day changed
+	MismatchRuleset        = "ruleset_change"  // Scored with an older scoring ruleset
+)
+
+// Sources a stored analysis is read from.
+const (
+	SourceNamesMiracle = "names_miracle"
+	SourceSavedNames   = "saved_names"
+)
+
+// ConsistencyMismatch is one stored field that differs from the live engine.
+type ConsistencyMismatch struct {
+	Source string `json:"source"`
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Day    string `json:"day,omitempty"` // Birth day of a saved name
+	Cause  string `json:"cause"`
+	Field  string `json:"field"`
+	Stored string `json:"stored"`
+	Live   string `json:"live"`
+}
+
+// ConsistencyReport lists every mismatch found by a consistency check.
+type ConsistencyReport struct {
+	RulesetVersion      int                   `json:"ruleset_version"`
+	CheckedNamesMiracle int                   `json:"checked_names_miracle"`
+	CheckedSavedNames   int                   `json:"checked_saved_names"`
+	ByCause             map[string]int        `json:"by_cause"` // Mismatching rows per cause
+	Mismatches          []ConsistencyMismatch `json:"mismatches"`
+}
@@ -0,0 +1,73 @@
+package domain
+
+import "time"
+
+// Kinds of conflict between the two people of a couple.
+const (
+	CoupleConflictKlakini = "klakini" // A letter of one name is klakini for the partner's birth day
+	CoupleConflictTaksa   = "taksa"   // The partner's birth day falls on one's own กาลกิณี
+)
+
+// Compatibility levels, best first.
+const (
+	CoupleLevelExcellent = "excellent"
+	CoupleLevelGood      = "good"
+	CoupleLevelCaution   = "caution"
+)
+
+// CouplePerson is one side of a compatibility check.
+type CouplePerson struct {
+	Name    string `json:"name"`
+	Surname string `json:"surname,omitempty"`
+	Day     string `json:"day"`
+}
+
+// CoupleConflict is one clash between the names or birth days of a couple.
+// Person is the index (0 or 1) of the person whose name or day causes it.
+type CoupleConflict struct {
+	Kind   string   `json:"kind"`
+	Person int      `json:"person"`
+	Chars  []string `json:"chars,omitempty"`
+	Reason string   `json:"reason"`
+}
+
+// CoupleCompatibility is the result of scoring two people together.
+type CoupleCompatibility struct {
+	People   [2]CouplePerson  `json:"people"`
+	Analyses [2]*NameAnalysis `json:"analyses"` // Each person's full name for their own birth day
+
+	// Combined scores the sum of both people's sat and sha totals.
+	Combined *NameAnalysis `json:"combined"`
+	// Cross pairs join the last digit of each person's sum, in both orders.
+	CrossSatPairs []PairMeaningResult `json:"cross_sat_pairs"`
+	CrossShaPairs []PairMeaningResult `json:"cross_sha_pairs"`
+
+	// Taksa group each person's birth day takes on the partner's wheel.
+	DayTaksa [2]string `json:"day_taksa"`
+
+	Conflicts []CoupleConflict `json:"conflicts"`
+	Score     int              `json:"score"`
+	Level     string           `json:"level"`
+	Verdict   string           `json:"verdict"`
+}
+
+// SavedCouple is a couple a member kept on their dashboard. The result is
+// recomputed when shown, so only the inputs and the score at save time are stored.
+type SavedCouple struct {
+	ID        int          `json:"id"`
+	CreatedAt time.Time    `json:"created_at"`
+	DeletedAt *time.Time   `json:"deleted_at"`
+	UserID    int          `json:"user_id"`
+	First     CouplePerson `json:"first"`
+	Second    CouplePerson `json:"second"`
+	Score     int          `json:"score"`
+
+	RulesetVersion int `json:"ruleset_version"`
+}
+
+type SavedCoupleDisplay struct {
+	SavedCouple
+	FirstDayThai  string               `json:"first_day_thai"`
+	SecondDayThai string               `json:"second_day_thai"`
+	Result        *CoupleCompatibility `json:"result"` // Rescored with the active ruleset
+}
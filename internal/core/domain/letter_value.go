@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"errors"
+	"unicode/utf8"
+)
+
+// LetterValue is one letter of the admin letter grid: its value in sat_nums and
+// sha_nums, nil when the table has no row for it, and the birth days it is
+// klakini on (kakis_day).
+type LetterValue struct {
+	Char        string   `json:"char"`
+	SatValue    *int     `json:"sat_value"`
+	ShaValue    *int     `json:"sha_value"`
+	KlakiniDays []string `json:"klakini_days"`
+}
+
+// Validate checks the letter is one character and its values are 0 to 99.
+func (l *LetterValue) Validate() error {
+	if utf8.RuneCountInString(l.Char) != 1 {
+		return errors.New("char must be a single letter")
+	}
+	for _, v := range []*int{l.SatValue, l.ShaValue} {
+		if v != nil && (*v < 0 || *v > 99) {
+			return errors.New("values must be between 0 and 99")
+		}
+	}
+	return nil
+}
+
+// IsKlakiniOn reports whether day is one of the klakini days of the letter.
+func (l *LetterValue) IsKlakiniOn(day string) bool {
+	for _, d := range l.KlakiniDays {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+// LetterScore is what the letter preview shows of one analysis.
+type LetterScore struct {
+	SatTotal   int    `json:"sat_total"`
+	ShaTotal   int    `json:"sha_total"`
+	TotalScore int    `json:"total_score"`
+	Tier       string `json:"tier"` // TierTop, TierBad or TierNormal
+	Klakini    bool   `json:"klakini"`
+}
+
+// LetterPreviewName is a sample name scored before and after a letter edit.
+type LetterPreviewName struct {
+	Name    string      `json:"name"`
+	Before  LetterScore `json:"before"`
+	After   LetterScore `json:"after"`
+	Changed bool        `json:"changed"`
+}
+
+// LetterPreview is how a letter edit changes the sample names for one day.
+type LetterPreview struct {
+	Char  string              `json:"char"`
+	Day   string              `json:"day"`
+	Names []LetterPreviewName `json:"names"`
+}
@@ -9,19 +9,20 @@ type Member struct {
 	ID       int    `json:"id"`
 	Username string `json:"username"`
 
-	Provider               string     `json:"provider"`    // "line", "facebook", "google"
-	ProviderID             string     `json:"provider_id"` // Unique ID from provider
-	Email                  string     `json:"email"`
-	AvatarURL              string     `json:"avatar_url"`
-	Tel                    string     `json:"tel"`
-	Status                 int        `json:"status"`
-	DayOfBirth             *int       `json:"day_of_birth"` // 0=Sunday, 1=Monday, ..., 6=Saturday
-	AssignedColors         string     `json:"assigned_colors"`
-	VIPExpiresAt           *time.Time `json:"vip_expires_at"`
-	WalletColorsNotifiedAt *time.Time `json:"wallet_colors_notified_at"` // New: tracked for notification status
-	CreatedAt              time.Time  `json:"created_at"`
-	UpdatedAt              time.Time  `json:"updated_at"`
-	DeletedAt              *time.Time `json:"deleted_at"`
+	Provider               string       `json:"provider"`    // "line", "facebook", "google"
+	ProviderID             string       `json:"provider_id"` // Unique ID from provider
+	Email                  string       `json:"email"`
+	AvatarURL              string       `json:"avatar_url"`
+	Tel                    string       `json:"tel"`
+	Status                 int          `json:"status"`
+	DayOfBirth             *int         `json:"day_of_birth"` // 0=Sunday, 1=Monday, ..., 6=Saturday
+	BirthMoment            *BirthMoment `json:"birth_moment"` // Date and optional time; resolves Wednesday night
+	AssignedColors         string       `json:"assigned_colors"`
+	VIPExpiresAt           *time.Time   `json:"vip_expires_at"`
+	WalletColorsNotifiedAt *time.Time   `json:"wallet_colors_notified_at"` // New: tracked for notification status
+	CreatedAt              time.Time    `json:"created_at"`
+	UpdatedAt              time.Time    `json:"updated_at"`
+	DeletedAt              *time.Time   `json:"deleted_at"`
 }
 
 const (
@@ -31,6 +32,22 @@ const (
 	StatusVIP    = 2
 )
 
+// BirthDay is the Thai astrological birth day (sunday ... wednesday2 ... saturday).
+// It prefers the birth moment and falls back to day_of_birth, which cannot tell
+// Wednesday night apart. Empty when neither is set.
+func (m *Member) BirthDay() string {
+	if m == nil {
+		return ""
+	}
+	if m.BirthMoment != nil {
+		return m.BirthMoment.Day()
+	}
+	if m.DayOfBirth != nil {
+		return weekdayBirthDays[time.Weekday(*m.DayOfBirth)]
+	}
+	return ""
+}
+
 func (m *Member) IsVIP() bool {
 	if m == nil {
 		return false
@@ -0,0 +1,139 @@
+package domain
+
+// NameAnalysis is the single result produced by the numerology engine for a name.
+// Every handler, the admin importer and the mobile API read from this struct so that
+// the same name always scores the same everywhere.
+type NameAnalysis struct {
+	Name   string `json:"name"`
+	Day    string `json:"day"`
+	Script string `json:"script"` // thai, latin or mixed
+
+	PhoneticKey string `json:"phonetic_key,omitempty"` // How the name sounds, see numerology.PhoneticKey
+
+	RulesetVersion int `json:"ruleset_version"` // Scoring ruleset that produced this analysis
+
+	// Per-component values (consonant, vowel, tone mark). Empty when the analysis
+	// was built from stored pairs instead of the name itself.
+	Chars    []DecodedResult `json:"chars"`
+	SatTotal int             `json:"sat_total"`
+	ShaTotal int             `json:"sha_total"`
+
+	SatNum   []string            `json:"sat_num"`
+	ShaNum   []string            `json:"sha_num"`
+	SatPairs []PairMeaningResult `json:"sat_pairs"` // Only pairs known to number_pairs
+	ShaPairs []PairMeaningResult `json:"sha_pairs"`
+	TSat     []PairTypeInfo      `json:"t_sat"`
+	TSha     []PairTypeInfo      `json:"t_sha"`
+
+	SatPositive int `json:"sat_positive"`
+	SatNegative int `json:"sat_negative"`
+	ShaPositive int `json:"sha_positive"`
+	ShaNegative int `json:"sha_negative"`
+	TotalScore  int `json:"total_score"`
+
+	HasBadPair bool `json:"has_bad_pair"`
+	IsTopTier  bool `json:"is_top_tier"`
+
+	KlakiniChars []string        `json:"klakini_chars"`
+	DisplayChars []DisplayChar   `json:"display_chars"`
+	KlakiniDays  map[string]bool `json:"klakini_days,omitempty"` // Filled only when all days are requested
+
+	Taksa        []TaksaLetter `json:"taksa,omitempty"`         // Taksa group of each letter for Day
+	LeadingTaksa string        `json:"leading_taksa,omitempty"` // Group of the first sounded letter
+
+	CategoryCounts    map[string]int               `json:"category_counts"`
+	CategoryBreakdown map[string]CategoryBreakdown `json:"category_breakdown"`
+}
+
+// FullNameAnalysis holds the three pillars of a Thai full name: first name, surname
+// and the combined name, each scored on its own sums.
+type FullNameAnalysis struct {
+	First    *NameAnalysis `json:"first"`
+	Last     *NameAnalysis `json:"last"`
+	Combined *NameAnalysis `json:"combined"`
+}
+
+// CombinedPillar is the compact combined-name score attached to a similar-name suggestion.
+type CombinedPillar struct {
+	FullName     string         `json:"full_name"`
+	SatNum       []string       `json:"sat_num"`
+	ShaNum       []string       `json:"sha_num"`
+	TSat         []PairTypeInfo `json:"t_sat"`
+	TSha         []PairTypeInfo `json:"t_sha"`
+	TotalScore   int            `json:"total_score"`
+	HasBadPair   bool           `json:"has_bad_pair"`
+	IsTopTier    bool           `json:"is_top_tier"`
+	KlakiniChars []string       `json:"klakini_chars"`
+}
+
+// ToCombinedPillar trims the analysis down to what a suggestion row needs.
+func (a *NameAnalysis) ToCombinedPillar() *CombinedPillar {
+	return &CombinedPillar{
+		FullName:     a.Name,
+		SatNum:       a.SatNum,
+		ShaNum:       a.ShaNum,
+		TSat:         a.TSat,
+		TSha:         a.TSha,
+		TotalScore:   a.TotalScore,
+		HasBadPair:   a.HasBadPair,
+		IsTopTier:    a.IsTopTier,
+		KlakiniChars: a.KlakiniChars,
+	}
+}
+
+// SatPairInfos returns the numerology pillar as the compact PairInfo list used by saved-name views.
+func (a *NameAnalysis) SatPairInfos() []PairInfo {
+	return toPairInfos(a.SatNum, a.SatPairs)
+}
+
+// ShaPairInfos returns the shadow pillar as the compact PairInfo list used by saved-name views.
+func (a *NameAnalysis) ShaPairInfos() []PairInfo {
+	return toPairInfos(a.ShaNum, a.ShaPairs)
+}
+
+// toPairInfos keeps pairs unknown to number_pairs with a neutral colour so the sum is still shown in full.
+func toPairInfos(pairs []string, meanings []PairMeaningResult) []PairInfo {
+	known := make(map[string]NumberPairMeaning, len(meanings))
+	for _, m := range meanings {
+		known[m.PairNumber] = m.Meaning
+	}
+
+	var infos []PairInfo
+	for _, p := range pairs {
+		info := PairInfo{Number: p, Color: "#ccc"}
+		if m, ok := known[p]; ok {
+			info.Color = m.Color
+			info.Type = m.PairType
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+// ToSimilarNameResult copies the analysis into the row shape stored in names_miracle.
+func (a *NameAnalysis) ToSimilarNameResult() *SimilarNameResult {
+	return &SimilarNameResult{
+		ThName:          a.Name,
+		PhoneticKey:     a.PhoneticKey,
+		DisplayNameHTML: a.DisplayChars,
+		KlakiniChars:    a.KlakiniChars,
+		SatNum:          a.SatNum,
+		ShaNum:          a.ShaNum,
+		TSat:            a.TSat,
+		TSha:            a.TSha,
+		TotalScore:      a.TotalScore,
+		IsTopTier:       a.IsTopTier,
+		HasBadPair:      a.HasBadPair,
+		KSunday:         a.KlakiniDays["sunday"],
+		KMonday:         a.KlakiniDays["monday"],
+		KTuesday:        a.KlakiniDays["tuesday"],
+		KWednesday1:     a.KlakiniDays["wednesday1"],
+		KWednesday2:     a.KlakiniDays["wednesday2"],
+		KThursday:       a.KlakiniDays["thursday"],
+		KFriday:         a.KlakiniDays["friday"],
+		KSaturday:       a.KlakiniDays["saturday"],
+		CategoryCounts:  a.CategoryCounts,
+		// Search metadata that follows from the analysis
+		CategoryStrengths: CategoryStrengths(a.CategoryBreakdown),
+	}
+}
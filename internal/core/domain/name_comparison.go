@@ -0,0 +1,16 @@
+package domain
+
+// ComparedName is one column of a name comparison.
+type ComparedName struct {
+	Rank     int           `json:"rank"` // 1 is the recommended name
+	Analysis *NameAnalysis `json:"analysis"`
+}
+
+// NameComparison scores a shortlist of names side by side for one birth day.
+type NameComparison struct {
+	Day          string         `json:"day"`
+	Names        []ComparedName `json:"names"` // In the order they were given
+	Winner       string         `json:"winner"`
+	WinnerIndex  int            `json:"winner_index"`
+	WinnerReason string         `json:"winner_reason"`
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Kinds of name dictionary entries.
+const (
+	DictionaryRoot     = "root"     // Pali/Sanskrit root, e.g. ธน "wealth"
+	DictionarySyllable = "syllable" // Syllable or prefix common in names, e.g. สุ
+	DictionaryName     = "name"     // Meaning of a whole name
+)
+
+// Where a dictionary entry came from, and whether an admin has reviewed it.
+const (
+	DictionarySourceCurated = "curated"
+	DictionarySourceLLM     = "llm"
+
+	DictionaryStatusApproved = "approved"
+	DictionaryStatusPending  = "pending"
+)
+
+// NameDictionaryEntry is one row of name_dictionary.
+type NameDictionaryEntry struct {
+	ID        int       `json:"id"`
+	Term      string    `json:"term"`
+	Kind      string    `json:"kind"`
+	Origin    string    `json:"origin"` // Language of origin, e.g. บาลี or สันสกฤต
+	Meaning   string    `json:"meaning"`
+	Source    string    `json:"source"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IsPending reports whether the entry still waits for an admin to review it.
+func (e *NameDictionaryEntry) IsPending() bool {
+	return e.Status == DictionaryStatusPending
+}
+
+// Validate trims the entry and checks its kind and status.
+func (e *NameDictionaryEntry) Validate() error {
+	e.Term = strings.TrimSpace(e.Term)
+	e.Kind = strings.ToLower(strings.TrimSpace(e.Kind))
+	e.Origin = strings.TrimSpace(e.Origin)
+	e.Meaning = strings.TrimSpace(e.Meaning)
+	if e.Term == "" {
+		return errors.New("term is required")
+	}
+	if e.Meaning == "" {
+		return errors.New("meaning is required")
+	}
+	switch e.Kind {
+	case DictionaryRoot, DictionarySyllable, DictionaryName:
+	default:
+		return errors.New("kind must be root, syllable or name")
+	}
+	if e.Source == "" {
+		e.Source = DictionarySourceCurated
+	}
+	switch e.Status {
+	case "":
+		e.Status = DictionaryStatusApproved
+	case DictionaryStatusApproved, DictionaryStatusPending:
+	default:
+		return errors.New("status must be approved or pending")
+	}
+	return nil
+}
+
+// NameMeaningPart is one piece of a name; Entry is nil when the dictionary does not know it.
+type NameMeaningPart struct {
+	Text  string               `json:"text"`
+	Entry *NameDictionaryEntry `json:"entry,omitempty"`
+}
+
+// NameMeaning is the meaning of a name composed from the dictionary: either a
+// whole-name entry or the parts the name splits into.
+type NameMeaning struct {
+	Name  string               `json:"name"`
+	Whole *NameDictionaryEntry `json:"whole,omitempty"`
+	Parts []NameMeaningPart    `json:"parts,omitempty"`
+}
+
+// Unknown returns the parts without a dictionary entry.
+func (m *NameMeaning) Unknown() []string {
+	var unknown []string
+	for _, p := range m.Parts {
+		if p.Entry == nil {
+			unknown = append(unknown, p.Text)
+		}
+	}
+	return unknown
+}
+
+// Known reports whether the dictionary explains any of the name.
+func (m *NameMeaning) Known() bool {
+	return m.Whole != nil || len(m.Unknown()) < len(m.Parts)
+}
+
+// DictionaryImportResult summarises a CSV import.
+type DictionaryImportResult struct {
+	Imported int      `json:"imported"`
+	Errors   []string `json:"errors,omitempty"` // One message per rejected line
+}
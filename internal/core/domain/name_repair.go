@@ -0,0 +1,30 @@
+package domain
+
+// Kinds of single-character edit tried by the name repair search.
+const (
+	RepairVowelSwap     = "vowel_swap"
+	RepairToneAdd       = "tone_add"
+	RepairToneRemove    = "tone_remove"
+	RepairToneSwap      = "tone_swap"
+	RepairConsonantSwap = "consonant_swap"
+	RepairSilentAdd     = "silent_add"
+	RepairSilentRemove  = "silent_remove"
+)
+
+// NameRepair is a variant of a name that differs by one character and has no bad
+// pairs and no klakini for the requested day.
+type NameRepair struct {
+	Name     string `json:"name"`
+	EditKind string `json:"edit_kind"`
+	Position int    `json:"position"` // Index of the edited cluster in DecodeName order
+	From     string `json:"from"`
+	To       string `json:"to"`
+
+	SatNum       []string       `json:"sat_num"`
+	ShaNum       []string       `json:"sha_num"`
+	TSat         []PairTypeInfo `json:"t_sat"`
+	TSha         []PairTypeInfo `json:"t_sha"`
+	TotalScore   int            `json:"total_score"`
+	IsTopTier    bool           `json:"is_top_tier"`
+	DisplayChars []DisplayChar  `json:"display_chars"`
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Who a name suits, stored in names_miracle.gender.
+const (
+	GenderMale   = "male"
+	GenderFemale = "female"
+	GenderUnisex = "unisex"
+)
+
+// Orders for suggested names besides the similarity ranking.
+const (
+	SortSimilarity = ""          // Similarity to the searched name (the default)
+	SortCategory   = "category"  // Strongest in NameSearchOptions.Category first
+	SortSyllables  = "syllables" // Fewest syllables first
+)
+
+// NameSearchOptions narrows and orders a similar-name search. The zero value
+// searches every name by written similarity.
+type NameSearchOptions struct {
+	Rank        string // RankSpelling, RankPhonetic or RankBlend
+	Gender      string // GenderMale or GenderFemale keeps that gender and unisex names; "" keeps all
+	Syllables   int    // Exact syllable count; 0 for any
+	FirstLetter string // First consonant, e.g. "ป"; "" for any
+	Category    string // Keep names strong in this category, e.g. "การเงิน"
+	Sort        string
+}
+
+// ParseGender accepts male/female (and boy/girl), returning "" for anything else.
+func ParseGender(s string) string {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case GenderMale, "boy", "ชาย":
+		return GenderMale
+	case GenderFemale, "girl", "หญิง":
+		return GenderFemale
+	}
+	return ""
+}
+
+// ParseNameSearchOptions reads the search options from query values.
+func ParseNameSearchOptions(rank, gender, syllables, firstLetter, category, sort string) NameSearchOptions {
+	opts := NameSearchOptions{
+		Rank:        ParseNameRank(rank),
+		Gender:      ParseGender(gender),
+		FirstLetter: strings.TrimSpace(firstLetter),
+		Category:    strings.TrimSpace(category),
+	}
+	if n, err := strconv.Atoi(strings.TrimSpace(syllables)); err == nil && n > 0 {
+		opts.Syllables = n
+	}
+	switch sort {
+	case SortCategory:
+		if opts.Category != "" {
+			opts.Sort = SortCategory
+		}
+	case SortSyllables:
+		opts.Sort = SortSyllables
+	}
+	return opts
+}
+
+// UsesPhonetic reports whether the ranking needs the phonetic key of the searched name.
+func (o NameSearchOptions) UsesPhonetic() bool {
+	return o.Rank == RankPhonetic || o.Rank == RankBlend
+}
+
+// Matches reports whether a stored names_miracle row passes the metadata filters.
+func (o NameSearchOptions) Matches(row *SimilarNameResult) bool {
+	if o.Gender != "" && row.Gender != o.Gender && row.Gender != GenderUnisex {
+		return false
+	}
+	if o.Syllables > 0 && row.Syllables != o.Syllables {
+		return false
+	}
+	if o.FirstLetter != "" && row.FirstLetter != o.FirstLetter {
+		return false
+	}
+	if o.Category != "" && row.CategoryStrengths[o.Category] <= 0 {
+		return false
+	}
+	return true
+}
+
+// CategoryStrengths scores each category as its good pairs minus its bad pairs.
+func CategoryStrengths(breakdown map[string]CategoryBreakdown) map[string]int {
+	strengths := make(map[string]int, len(breakdown))
+	for cat, b := range breakdown {
+		strengths[cat] = b.Good - b.Bad
+	}
+	return strengths
+}
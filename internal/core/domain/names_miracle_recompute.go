@@ -0,0 +1,47 @@
+package domain
+
+import "time"
+
+// ColumnChange is one precomputed column of names_miracle whose stored value
+// differs from what the current engine computes.
+type ColumnChange struct {
+	Column string `json:"column"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// NamesMiracleDiff lists the changed columns of one row.
+type NamesMiracleDiff struct {
+	NameID  int            `json:"name_id"`
+	Name    string         `json:"name"`
+	Changes []ColumnChange `json:"changes"`
+}
+
+// RecomputeProgress is reported after every batch of a recompute run.
+type RecomputeProgress struct {
+	Scanned int `json:"scanned"`
+	Total   int `json:"total"`
+	Changed int `json:"changed"`
+}
+
+// RecomputeReport summarises a recompute run of names_miracle.
+type RecomputeReport struct {
+	DryRun     bool      `json:"dry_run"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	Scanned    int       `json:"scanned"`
+	Changed    int       `json:"changed"` // Rows whose stored values differ
+	Updated    int       `json:"updated"` // Rows written; 0 in a dry run
+	// Invalid rows hold names the engine can no longer score; they are left untouched.
+	Invalid      []NamesMiracleDiff `json:"invalid,omitempty"`
+	ColumnCounts map[string]int     `json:"column_counts"` // Changed rows per column
+	Samples      []NamesMiracleDiff `json:"samples"`       // The first changed rows
+	Error        string             `json:"error,omitempty"`
+}
+
+// RecomputeStatus is the state of the background recompute job.
+type RecomputeStatus struct {
+	Running  bool              `json:"running"`
+	Progress RecomputeProgress `json:"progress"`
+	Last     *RecomputeReport  `json:"last,omitempty"` // Report of the last finished run
+}
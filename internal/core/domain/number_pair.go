@@ -1,5 +1,19 @@
 package domain
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Values of number_categories.number_type.
+const (
+	NumberTypeGood    = "ดี"
+	NumberTypeBad     = "ร้าย"
+	NumberTypeNeutral = "กลางๆ"
+)
+
 type NumberPairMeaning struct {
 	PairNumber    string   `json:"pair_number"`
 	PairType      string   `json:"pair_type"`
@@ -12,9 +26,107 @@ type NumberPairMeaning struct {
 	IsBad         bool     `json:"is_bad"`   // Added for UI logic
 }
 
+// Validate trims the editable columns of a numbers row and checks them against
+// the column sizes. Colour, category and keywords are not stored with the pair.
+func (m *NumberPairMeaning) Validate() error {
+	m.PairNumber = strings.TrimSpace(m.PairNumber)
+	m.PairType = strings.ToUpper(strings.TrimSpace(m.PairType))
+	m.MiracleDetail = strings.TrimSpace(m.MiracleDetail)
+	m.MiracleDesc = strings.TrimSpace(m.MiracleDesc)
+	if !IsPairNumber(m.PairNumber) {
+		return errors.New("pair_number must be two digits")
+	}
+	if m.PairType == "" || utf8.RuneCountInString(m.PairType) > 3 {
+		return errors.New("pair_type must be 1 to 3 characters, e.g. D10 or R7")
+	}
+	if utf8.RuneCountInString(m.MiracleDesc) > 95 {
+		return errors.New("miracle_desc must be at most 95 characters")
+	}
+	if m.PairPoint < -100 || m.PairPoint > 100 {
+		return errors.New("pair_point must be between -100 and 100")
+	}
+	return nil
+}
+
 type NumberCategory struct {
+	ID         int      `json:"id"`
 	PairNumber string   `json:"pair_number"`
 	Category   string   `json:"category"`
 	NumberType string   `json:"number_type"` // ดี or ร้าย
 	Keywords   []string `json:"keywords"`
 }
+
+// Validate trims the category and drops empty keywords.
+func (c *NumberCategory) Validate() error {
+	c.PairNumber = strings.TrimSpace(c.PairNumber)
+	c.Category = strings.TrimSpace(c.Category)
+	c.NumberType = strings.TrimSpace(c.NumberType)
+	keywords := c.Keywords[:0]
+	for _, k := range c.Keywords {
+		if k = strings.TrimSpace(k); k != "" {
+			keywords = append(keywords, k)
+		}
+	}
+	c.Keywords = keywords
+	if !IsPairNumber(c.PairNumber) {
+		return errors.New("pair_number must be two digits")
+	}
+	if c.Category == "" || utf8.RuneCountInString(c.Category) > 50 {
+		return errors.New("category must be 1 to 50 characters")
+	}
+	switch c.NumberType {
+	case NumberTypeGood, NumberTypeBad, NumberTypeNeutral:
+	default:
+		return fmt.Errorf("number_type must be %s, %s or %s", NumberTypeGood, NumberTypeBad, NumberTypeNeutral)
+	}
+	return nil
+}
+
+// IsPairNumber reports whether s is a two-digit pair such as "09".
+func IsPairNumber(s string) bool {
+	return len(s) == 2 && s[0] >= '0' && s[0] <= '9' && s[1] >= '0' && s[1] <= '9'
+}
+
+// Tiers a name or phone number falls in, by the pair types it is made of.
+const (
+	TierTop    = "top"    // Every pair is a top-tier type
+	TierBad    = "bad"    // At least one bad pair
+	TierNormal = "normal" // Anything else
+)
+
+// TierChange is one name or phone number whose tier an edit would change.
+type TierChange struct {
+	Label  string `json:"label"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// TierImpact counts the rows containing the edited pair and those whose tier changes.
+type TierImpact struct {
+	Affected int          `json:"affected"`
+	Changed  int          `json:"changed"`
+	Examples []TierChange `json:"examples"`
+}
+
+// maxTierExamples bounds the examples kept in a TierImpact.
+const maxTierExamples = 10
+
+// Add counts one row containing the pair, keeping a few of those that change tier.
+func (t *TierImpact) Add(label, before, after string) {
+	t.Affected++
+	if before == after {
+		return
+	}
+	t.Changed++
+	if len(t.Examples) < maxTierExamples {
+		t.Examples = append(t.Examples, TierChange{Label: label, Before: before, After: after})
+	}
+}
+
+// NumberDataImpact is the preview of an edit to number_pairs or number_categories,
+// over names_miracle and the phone numbers still for sale.
+type NumberDataImpact struct {
+	PairNumber string     `json:"pair_number"`
+	Names      TierImpact `json:"names"`
+	Phones     TierImpact `json:"phones"`
+}
@@ -1,5 +1,7 @@
 package domain
 
+import "strings"
+
 type PhoneNumberSell struct {
 	PNumberID       int    `json:"pnumber_id"`
 	PNumberPosition int    `json:"pnumber_position"`
@@ -11,6 +13,11 @@ type PhoneNumberSell struct {
 	PrefixGroup     string `json:"prefix_group"`
 }
 
+// IsSellable reports whether the number is still for sale.
+func (p PhoneNumberSell) IsSellable() bool {
+	return !strings.Contains(strings.ToLower(p.SellStatus), "sold")
+}
+
 type PhoneNumberPairMeaning struct {
 	Pair    string            `json:"pair"`
 	Meaning NumberPairMeaning `json:"meaning"`
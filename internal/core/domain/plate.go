@@ -0,0 +1,20 @@
+package domain
+
+// PlateAnalysis is the numerology reading of a Thai vehicle licence plate. The
+// plate is read as the sum of its letter values and digits; the province is
+// shown but does not count.
+type PlateAnalysis struct {
+	Plate    string `json:"plate"`    // Normalised, e.g. "1กข 9999"
+	Prefix   string `json:"prefix"`   // Leading digit of newer plates, may be empty
+	Letters  string `json:"letters"`  // The Thai letter series
+	Number   string `json:"number"`   // The 1-4 digit number
+	Province string `json:"province"` // Optional
+	Day      string `json:"day"`      // Owner's birth day, for klakini
+
+	Chars        []DecodedResult     `json:"chars"` // Value of every counted letter and digit
+	Sum          int                 `json:"sum"`
+	SumPairs     []PairMeaningResult `json:"sum_pairs"`
+	KlakiniChars []string            `json:"klakini_chars"`
+	HasBadPair   bool                `json:"has_bad_pair"`
+	TotalScore   int                 `json:"total_score"`
+}
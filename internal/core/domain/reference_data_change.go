@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Actions recorded in reference_data_changes.
+const (
+	ChangeCreate = "create"
+	ChangeUpdate = "update"
+	ChangeDelete = "delete"
+)
+
+// ReferenceDataChange is one admin edit of a reference table (numbers,
+// number_categories, ...). Before is empty for creates and After for deletes.
+type ReferenceDataChange struct {
+	ID        int             `json:"id"`
+	TableName string          `json:"table_name"`
+	RecordKey string          `json:"record_key"`
+	Action    string          `json:"action"`
+	Before    json.RawMessage `json:"before,omitempty"`
+	After     json.RawMessage `json:"after,omitempty"`
+	ChangedBy int             `json:"changed_by"` // member_id of the admin, 0 if unknown
+	ChangedAt time.Time       `json:"changed_at"`
+}
@@ -3,16 +3,21 @@ package domain
 import "time"
 
 type SavedName struct {
-	ID         int        `json:"id"`
-	CreatedAt  time.Time  `json:"created_at"`
-	UpdatedAt  time.Time  `json:"updated_at"`
-	DeletedAt  *time.Time `json:"deleted_at"`
-	UserID     int        `json:"user_id"`
-	Name       string     `json:"name"`
-	BirthDay   string     `json:"birth_day"`
-	TotalScore int        `json:"total_score"`
-	SatSum     int        `json:"sat_sum"`
-	ShaSum     int        `json:"sha_sum"`
+	ID        int        `json:"id"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at"`
+	UserID    int        `json:"user_id"`
+	Name      string     `json:"name"`
+	Surname   string     `json:"surname"` // Optional; empty when only a first name was saved
+	BirthDay  string     `json:"birth_day"`
+	// Birth date and time the day was derived from; nil when only a day was given.
+	BirthMoment *BirthMoment `json:"birth_moment,omitempty"`
+	TotalScore  int          `json:"total_score"`
+	SatSum      int          `json:"sat_sum"`
+	ShaSum      int          `json:"sha_sum"`
+
+	RulesetVersion int `json:"ruleset_version"` // Scoring ruleset the score was computed with
 }
 
 type SavedNameDisplay struct {
@@ -24,6 +29,13 @@ type SavedNameDisplay struct {
 	ShaPairs        []PairInfo    `json:"sha_pairs"`
 	DisplayNameHTML []DisplayChar `json:"display_name_html"`
 	IsTopTier       bool          `json:"is_top_tier"`
+
+	// Surname and combined full-name pillars, filled only when a surname was saved.
+	SurnameSatPairs  []PairInfo `json:"surname_sat_pairs,omitempty"`
+	SurnameShaPairs  []PairInfo `json:"surname_sha_pairs,omitempty"`
+	FullNameSatPairs []PairInfo `json:"full_name_sat_pairs,omitempty"`
+	FullNameShaPairs []PairInfo `json:"full_name_sha_pairs,omitempty"`
+	FullNameScore    int        `json:"full_name_score,omitempty"`
 }
 
 type PairInfo struct {
@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Classes a pair type can belong to in a scoring ruleset.
+const (
+	PairClassGood    = "good"
+	PairClassBad     = "bad"
+	PairClassNeutral = "neutral"
+)
+
+// PairTier classifies one pair type (as stored in number_pairs.pairtype) and sets its colour.
+type PairTier struct {
+	PairType string `json:"pair_type"`
+	Class    string `json:"class"`
+	Color    string `json:"color"`
+}
+
+// RulesetPenalties are points taken off the total score on top of the pair points.
+type RulesetPenalties struct {
+	BadPair int `json:"bad_pair"` // Per bad pair in either pillar
+	Klakini int `json:"klakini"`  // Per klakini character for the chosen day
+}
+
+// ScoringRules is the editable body of a ruleset, stored as JSON.
+type ScoringRules struct {
+	Tiers            []PairTier       `json:"tiers"`
+	BadPairColor     string           `json:"bad_pair_color"`     // Overrides the tier colour of bad pairs in name analyses
+	UnknownPairColor string           `json:"unknown_pair_color"` // Colour of pair types without a tier
+	TopTierTypes     []string         `json:"top_tier_types"`     // A name is top tier when every pair is one of these
+	Penalties        RulesetPenalties `json:"penalties"`
+}
+
+// ScoringRuleset is one immutable version of the scoring rules. Editing creates a
+// new version; exactly one version is active at a time.
+type ScoringRuleset struct {
+	ID          int          `json:"id"`
+	Version     int          `json:"version"`
+	Name        string       `json:"name"`
+	Rules       ScoringRules `json:"rules"`
+	IsActive    bool         `json:"is_active"`
+	CreatedAt   time.Time    `json:"created_at"`
+	ActivatedAt *time.Time   `json:"activated_at"`
+}
+
+func (r *ScoringRuleset) tier(pairType string) (PairTier, bool) {
+	pairType = strings.TrimSpace(pairType)
+	for _, t := range r.Rules.Tiers {
+		if t.PairType == pairType {
+			return t, true
+		}
+	}
+	return PairTier{}, false
+}
+
+// IsGood reports whether pairType is classed as good.
+func (r *ScoringRuleset) IsGood(pairType string) bool {
+	t, ok := r.tier(pairType)
+	return ok && t.Class == PairClassGood
+}
+
+// IsBad reports whether pairType is classed as bad.
+func (r *ScoringRuleset) IsBad(pairType string) bool {
+	t, ok := r.tier(pairType)
+	return ok && t.Class == PairClassBad
+}
+
+// IsTopTierType reports whether pairType may appear in a top-tier name.
+func (r *ScoringRuleset) IsTopTierType(pairType string) bool {
+	pairType = strings.TrimSpace(pairType)
+	for _, t := range r.Rules.TopTierTypes {
+		if t == pairType {
+			return true
+		}
+	}
+	return false
+}
+
+// TierColor is the colour of pairType's tier, or the unknown colour.
+func (r *ScoringRuleset) TierColor(pairType string) string {
+	if t, ok := r.tier(pairType); ok && t.Color != "" {
+		return t.Color
+	}
+	return r.Rules.UnknownPairColor
+}
+
+// PairColor is the colour a pair is shown with in name analyses: bad pairs use
+// the shared bad-pair colour when one is set.
+func (r *ScoringRuleset) PairColor(pairType string) string {
+	if r.IsBad(pairType) && r.Rules.BadPairColor != "" {
+		return r.Rules.BadPairColor
+	}
+	return r.TierColor(pairType)
+}
+
+// BadTypes lists the pair types classed as bad, for SQL array filters.
+func (r *ScoringRuleset) BadTypes() []string {
+	var types []string
+	for _, t := range r.Rules.Tiers {
+		if t.Class == PairClassBad {
+			types = append(types, t.PairType)
+		}
+	}
+	return types
+}
+
+// Validate checks the rules before a new version is stored.
+func (r *ScoringRules) Validate() error {
+	if len(r.Tiers) == 0 {
+		return errors.New("ruleset must define at least one pair tier")
+	}
+	seen := make(map[string]bool)
+	for _, t := range r.Tiers {
+		if strings.TrimSpace(t.PairType) == "" {
+			return errors.New("pair tier is missing pair_type")
+		}
+		if seen[t.PairType] {
+			return fmt.Errorf("pair type %s is defined twice", t.PairType)
+		}
+		seen[t.PairType] = true
+		if t.Class != PairClassGood && t.Class != PairClassBad && t.Class != PairClassNeutral {
+			return fmt.Errorf("pair type %s has unknown class %q", t.PairType, t.Class)
+		}
+	}
+	if len(r.TopTierTypes) == 0 {
+		return errors.New("ruleset must define top_tier_types")
+	}
+	for _, t := range r.TopTierTypes {
+		if !seen[t] {
+			return fmt.Errorf("top tier type %s has no pair tier", t)
+		}
+	}
+	if r.Penalties.BadPair < 0 || r.Penalties.Klakini < 0 {
+		return errors.New("penalties must not be negative")
+	}
+	return nil
+}
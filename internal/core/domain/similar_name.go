@@ -12,10 +12,41 @@ type DisplayChar struct {
 	IsBad bool   `json:"is_bad"`
 }
 
+// Ways to rank suggested names against the searched name.
+const (
+	RankSpelling = "spelling" // Written similarity (the default)
+	RankPhonetic = "phonetic" // Similarity of the phonetic keys
+	RankBlend    = "blend"    // Both, weighted by PhoneticBlendWeight
+)
+
+// PhoneticBlendWeight is the share of the phonetic similarity in RankBlend.
+const PhoneticBlendWeight = 0.5
+
+// ParseNameRank returns the ranking for a query value, RankSpelling when unknown.
+func ParseNameRank(s string) string {
+	switch s {
+	case RankPhonetic, RankBlend:
+		return s
+	}
+	return RankSpelling
+}
+
+// BlendSimilarity combines written and phonetic similarity for a ranking.
+func BlendSimilarity(rank string, spelling, phonetic float64) float64 {
+	switch rank {
+	case RankPhonetic:
+		return phonetic
+	case RankBlend:
+		return (1-PhoneticBlendWeight)*spelling + PhoneticBlendWeight*phonetic
+	}
+	return spelling
+}
+
 type SimilarNameResult struct {
 	HeaderDisplayNameHTML []DisplayChar  // New field for header rendering with combined consonant+vowel
 	NameID                int            `json:"name_id"`
 	ThName                string         `json:"th_name"`
+	PhoneticKey           string         `json:"phonetic_key,omitempty"`
 	DisplayNameHTML       []DisplayChar  `json:"display_name_html"` // Changed to a slice of DisplayChar
 	KlakiniChars          []string       `json:"klakini_chars"`     // New field for Klakini characters
 	SatNum                []string       `json:"sat_num"`
@@ -25,6 +56,7 @@ type SimilarNameResult struct {
 	Distance              float64        `json:"distance"`
 	TotalScore            int            `json:"total_score"`
 	Similarity            float64        `json:"similarity"`
+	LeadingTaksa          string         `json:"leading_taksa,omitempty"` // Taksa group of the first letter for the searched day
 	IsTopTier             bool           `json:"is_top_tier"`
 	HasBadPair            bool           `json:"has_bad_pair"`
 
@@ -39,4 +71,13 @@ type SimilarNameResult struct {
 	KSaturday   bool `json:"k_saturday"`
 
 	CategoryCounts map[string]int `json:"category_counts"`
+
+	// Search metadata stored with the name, see NameSearchOptions.
+	Gender            string         `json:"gender,omitempty"`
+	Syllables         int            `json:"syllables,omitempty"`
+	FirstLetter       string         `json:"first_letter,omitempty"`
+	CategoryStrengths map[string]int `json:"category_strengths,omitempty"`
+
+	// Combined pillar with the requested surname, filled only for full-name searches.
+	Combined *CombinedPillar `json:"combined,omitempty"`
 }
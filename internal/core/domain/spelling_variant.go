@@ -0,0 +1,20 @@
+package domain
+
+// SpellingVariant is one way of writing a name that reads the same as the input,
+// scored for the requested birth day.
+type SpellingVariant struct {
+	Name       string `json:"name"`
+	IsOriginal bool   `json:"is_original"`
+
+	SatNum       []string       `json:"sat_num"`
+	ShaNum       []string       `json:"sha_num"`
+	TSat         []PairTypeInfo `json:"t_sat"`
+	TSha         []PairTypeInfo `json:"t_sha"`
+	SatPairs     []PairInfo     `json:"sat_pairs"`
+	ShaPairs     []PairInfo     `json:"sha_pairs"`
+	TotalScore   int            `json:"total_score"`
+	HasBadPair   bool           `json:"has_bad_pair"`
+	IsTopTier    bool           `json:"is_top_tier"`
+	KlakiniChars []string       `json:"klakini_chars"`
+	DisplayChars []DisplayChar  `json:"display_chars"`
+}
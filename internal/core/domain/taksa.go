@@ -0,0 +1,50 @@
+package domain
+
+// The eight Taksa (ทักษา) groups, in the order they are counted round the wheel
+// from the birth day.
+const (
+	TaksaBoriwan  = "บริวาร"
+	TaksaAyu      = "อายุ"
+	TaksaDet      = "เดช"
+	TaksaSri      = "ศรี"
+	TaksaMula     = "มูละ"
+	TaksaUtsaha   = "อุตสาหะ"
+	TaksaMontri   = "มนตรี"
+	TaksaKalakini = "กาลกิณี"
+)
+
+var TaksaGroups = []string{TaksaBoriwan, TaksaAyu, TaksaDet, TaksaSri, TaksaMula, TaksaUtsaha, TaksaMontri, TaksaKalakini}
+
+// Taksa is one row of taksa_day: the letters that fall into a group for a birth day.
+type Taksa struct {
+	Day   string // e.g., "monday"
+	Group string // e.g., "เดช"
+	Chars string // e.g., "ฎฏฐฑฒณ"
+}
+
+// TaksaLetter is the Taksa group of one letter of a name.
+type TaksaLetter struct {
+	Char  string `json:"char"`
+	Group string `json:"group"`
+}
+
+// TaksaWheel is the order of the birth days round the Taksa wheel.
+var TaksaWheel = []string{BirthDaySunday, BirthDayMonday, BirthDayTuesday, BirthDayWednesday1, BirthDaySaturday, BirthDayThursday, BirthDayWednesday2, BirthDayFriday}
+
+// TaksaDayGroup is the group other falls into when counting round the wheel from
+// day, e.g. Monday is อายุ for someone born on Sunday. It is empty for an unknown day.
+func TaksaDayGroup(day, other string) string {
+	from, to := -1, -1
+	for i, d := range TaksaWheel {
+		if d == day {
+			from = i
+		}
+		if d == other {
+			to = i
+		}
+	}
+	if from < 0 || to < 0 {
+		return ""
+	}
+	return TaksaGroups[(to-from+len(TaksaWheel))%len(TaksaWheel)]
+}
@@ -0,0 +1,95 @@
+package numerology
+
+import (
+	"numberniceic/internal/core/domain"
+	"sort"
+)
+
+// BusinessCategories are the categories a business name is judged on, most important first.
+var BusinessCategories = []string{"การเงิน", "การงาน"}
+
+// MaxBrandSuggestions bounds how many affixes AnalyzeBusiness suggests.
+const MaxBrandSuggestions = 12
+
+// Common words shops put around their brand, in Thai, English and digits.
+var (
+	brandPrefixes = []string{"ร้าน", "บ้าน", "The", "My", "Mr", "Happy", "Smart", "Royal", "Siam", "Thai"}
+	brandSuffixes = []string{
+		"ช็อป", "มาร์ท", "คาเฟ่", "เฮาส์", "กรุ๊ป", "พลัส", "ออนไลน์", "สตูดิโอ", "เอ็กซ์เพรส",
+		"Shop", "Store", "Mart", "Cafe", "House", "Group", "Plus", "Online", "Studio", "Express", "Official", "Thailand",
+		"1", "9", "24", "88", "99", "168", "789", "888", "999",
+	}
+)
+
+// AnalyzeBusiness scores a brand name for its opening day, pulls out the business
+// categories and suggests affixes that turn the total into top-tier pairs.
+func (e *Engine) AnalyzeBusiness(name, day string) *domain.BusinessNameAnalysis {
+	e = e.pin()
+	a := e.Analyze(name, day, Options{})
+	result := &domain.BusinessNameAnalysis{Analysis: a}
+	for _, cat := range BusinessCategories {
+		result.Focus = append(result.Focus, domain.BusinessFocus{Category: cat, CategoryBreakdown: a.CategoryBreakdown[cat]})
+	}
+	result.Suggestions = e.BrandSuggestions(a, day, MaxBrandSuggestions)
+	return result
+}
+
+// BrandSuggestions tries every known prefix and suffix on the analysed brand and
+// keeps those where every sat and sha pair of the new total is a top-tier type.
+// Affixes with a klakini letter for day are skipped. The best come first: more
+// good pairs in the business categories, then a higher total score.
+func (e *Engine) BrandSuggestions(brand *domain.NameAnalysis, day string, limit int) []domain.BrandSuggestion {
+	e = e.pin()
+	rs := e.Ruleset()
+	type candidate struct {
+		suggestion domain.BrandSuggestion
+		good       int
+		score      int
+	}
+	var candidates []candidate
+	try := func(affix, position string) {
+		if len(e.KlakiniChars(affix, day)) > 0 {
+			return
+		}
+		extra := e.Analyze(affix, day, Options{})
+		sat, sha := brand.SatTotal+extra.SatTotal, brand.ShaTotal+extra.ShaTotal
+		if !e.isStrictPremium(rs, SplitPairs(sat)) || !e.isStrictPremium(rs, SplitPairs(sha)) {
+			return
+		}
+		full := brand.Name + " " + affix
+		if position == domain.BrandAffixPrefix {
+			full = affix + " " + brand.Name
+		}
+		a := e.AnalyzeTotals(full, day, sat, sha, Options{})
+		good := 0
+		for _, cat := range BusinessCategories {
+			good += a.CategoryBreakdown[cat].Good
+		}
+		candidates = append(candidates, candidate{
+			suggestion: domain.BrandSuggestion{Affix: affix, Position: position, Pillar: a.ToCombinedPillar()},
+			good:       good,
+			score:      a.TotalScore,
+		})
+	}
+	for _, p := range brandPrefixes {
+		try(p, domain.BrandAffixPrefix)
+	}
+	for _, s := range brandSuffixes {
+		try(s, domain.BrandAffixSuffix)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].good != candidates[j].good {
+			return candidates[i].good > candidates[j].good
+		}
+		return candidates[i].score > candidates[j].score
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	suggestions := make([]domain.BrandSuggestion, len(candidates))
+	for i, c := range candidates {
+		suggestions[i] = c.suggestion
+	}
+	return suggestions
+}
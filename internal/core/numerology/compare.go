@@ -0,0 +1,99 @@
+package numerology
+
+import (
+	"numberniceic/internal/core/domain"
+	"sort"
+)
+
+// Bounds on how many names a comparison takes.
+const (
+	MinCompareNames = 2
+	MaxCompareNames = 5
+)
+
+// Compare analyses every name for day and ranks them. The winner is decided by,
+// in order: top-tier status, having no bad pair, fewer klakini characters, total
+// score and finally the number of good pairs across the four life categories.
+func (e *Engine) Compare(names []string, day string) *domain.NameComparison {
+	e = e.pin()
+	result := &domain.NameComparison{Day: day}
+	analyses := make([]*domain.NameAnalysis, len(names))
+	for i, name := range names {
+		analyses[i] = e.Analyze(name, day, Options{})
+	}
+
+	order := make([]int, len(analyses))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return compareRank(analyses[order[i]], analyses[order[j]]) < 0
+	})
+
+	ranks := make([]int, len(analyses))
+	for rank, idx := range order {
+		ranks[idx] = rank + 1
+	}
+	for i, a := range analyses {
+		result.Names = append(result.Names, domain.ComparedName{Rank: ranks[i], Analysis: a})
+	}
+
+	if len(order) > 0 {
+		result.WinnerIndex = order[0]
+		result.Winner = analyses[order[0]].Name
+		if len(order) > 1 {
+			result.WinnerReason = winnerReason(analyses[order[0]], analyses[order[1]])
+		}
+	}
+	return result
+}
+
+// compareRank orders a before b (negative) when a is the better name.
+func compareRank(a, b *domain.NameAnalysis) int {
+	if a.IsTopTier != b.IsTopTier {
+		return boolRank(a.IsTopTier)
+	}
+	if a.HasBadPair != b.HasBadPair {
+		return boolRank(!a.HasBadPair)
+	}
+	if len(a.KlakiniChars) != len(b.KlakiniChars) {
+		return len(a.KlakiniChars) - len(b.KlakiniChars)
+	}
+	if a.TotalScore != b.TotalScore {
+		return b.TotalScore - a.TotalScore
+	}
+	return goodCategoryPairs(b) - goodCategoryPairs(a)
+}
+
+func boolRank(better bool) int {
+	if better {
+		return -1
+	}
+	return 1
+}
+
+func goodCategoryPairs(a *domain.NameAnalysis) int {
+	total := 0
+	for _, b := range a.CategoryBreakdown {
+		total += b.Good
+	}
+	return total
+}
+
+// winnerReason names the first criterion that separates the winner from the runner-up.
+func winnerReason(winner, runnerUp *domain.NameAnalysis) string {
+	switch {
+	case winner.IsTopTier != runnerUp.IsTopTier:
+		return "เป็นชื่อระดับสูงสุด คู่เลขดีทุกคู่ทั้งเลขศาสตร์และพลังเงา"
+	case winner.HasBadPair != runnerUp.HasBadPair:
+		return "ไม่มีคู่เลขร้าย"
+	case len(winner.KlakiniChars) != len(runnerUp.KlakiniChars):
+		return "มีอักษรกาลกิณีน้อยกว่า"
+	case winner.TotalScore != runnerUp.TotalScore:
+		return "คะแนนรวมสูงกว่า"
+	case goodCategoryPairs(winner) != goodCategoryPairs(runnerUp):
+		return "มีคู่เลขดีด้านการงาน การเงิน ความรัก และสุขภาพมากกว่า"
+	default:
+		return "คะแนนเท่ากัน เลือกชื่อที่ใส่ไว้ก่อน"
+	}
+}
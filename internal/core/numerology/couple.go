@@ -0,0 +1,82 @@
+package numerology
+
+import (
+	"fmt"
+	"numberniceic/internal/core/domain"
+	"strings"
+)
+
+// Couple scores two people together. Each person's full name is analysed for their
+// own birth day; the couple is then scored on the combined sat/sha totals, the
+// cross pairs made from the last digit of each person's sums, and the conflicts
+// between them: letters of one name that are klakini for the partner's birth day,
+// and birth days that fall on the partner's กาลกิณี in the Taksa wheel.
+func (e *Engine) Couple(first, second domain.CouplePerson) *domain.CoupleCompatibility {
+	e = e.pin()
+	rs := e.Ruleset()
+	result := &domain.CoupleCompatibility{People: [2]domain.CouplePerson{first, second}}
+	for i, p := range result.People {
+		result.Analyses[i] = e.Analyze(JoinFullName(p.Name, p.Surname), p.Day, Options{})
+	}
+	a, b := result.Analyses[0], result.Analyses[1]
+
+	// The combined name belongs to neither birth day, so no klakini is applied to it.
+	result.Combined = e.AnalyzeTotals(a.Name+" & "+b.Name, "", a.SatTotal+b.SatTotal, a.ShaTotal+b.ShaTotal, Options{})
+
+	var satPos, satNeg, shaPos, shaNeg int
+	result.CrossSatPairs, satPos, satNeg = e.scorePairs(rs, crossPairs(a.SatTotal, b.SatTotal))
+	result.CrossShaPairs, shaPos, shaNeg = e.scorePairs(rs, crossPairs(a.ShaTotal, b.ShaTotal))
+	badCross := countBadPairs(rs, result.CrossSatPairs) + countBadPairs(rs, result.CrossShaPairs)
+
+	for i, p := range result.People {
+		partner := result.People[1-i]
+		if chars := e.KlakiniChars(result.Analyses[i].Name, partner.Day); len(chars) > 0 {
+			result.Conflicts = append(result.Conflicts, domain.CoupleConflict{
+				Kind:   domain.CoupleConflictKlakini,
+				Person: i,
+				Chars:  chars,
+				Reason: fmt.Sprintf("ชื่อ %s มีอักษร %s ซึ่งเป็นกาลกิณีของวันเกิด %s", result.Analyses[i].Name, strings.Join(chars, " "), partner.Name),
+			})
+		}
+		result.DayTaksa[i] = domain.TaksaDayGroup(partner.Day, p.Day)
+		if result.DayTaksa[i] == domain.TaksaKalakini {
+			result.Conflicts = append(result.Conflicts, domain.CoupleConflict{
+				Kind:   domain.CoupleConflictTaksa,
+				Person: i,
+				Reason: fmt.Sprintf("วันเกิดของ %s ตกกาลกิณีในทักษาของ %s", p.Name, partner.Name),
+			})
+		}
+	}
+
+	result.Score = result.Combined.TotalScore + satPos + satNeg + shaPos + shaNeg -
+		badCross*rs.Rules.Penalties.BadPair - len(result.Conflicts)*rs.Rules.Penalties.Klakini
+	result.Level, result.Verdict = coupleLevel(result, badCross)
+	return result
+}
+
+// crossPairs joins the last digit of each sum in both orders, e.g. 45 and 38 give 58 and 85.
+func crossPairs(x, y int) []string {
+	forward := fmt.Sprintf("%d%d", x%10, y%10)
+	backward := fmt.Sprintf("%d%d", y%10, x%10)
+	if forward == backward {
+		return []string{forward}
+	}
+	return []string{forward, backward}
+}
+
+func coupleLevel(c *domain.CoupleCompatibility, badCross int) (string, string) {
+	taksaConflict := false
+	for _, conflict := range c.Conflicts {
+		if conflict.Kind == domain.CoupleConflictTaksa {
+			taksaConflict = true
+		}
+	}
+	switch {
+	case !c.Combined.HasBadPair && badCross == 0 && len(c.Conflicts) == 0:
+		return domain.CoupleLevelExcellent, "เข้ากันดีมาก ผลรวมและคู่เลขไขว้ส่งเสริมกัน ไม่มีกาลกิณีต่อกัน"
+	case !c.Combined.HasBadPair && !taksaConflict:
+		return domain.CoupleLevelGood, "เข้ากันได้ดี มีจุดที่ควรระวังเล็กน้อย"
+	default:
+		return domain.CoupleLevelCaution, "ควรระวัง มีคู่เลขร้ายหรือวันเกิดที่เป็นกาลกิณีต่อกัน"
+	}
+}
@@ -0,0 +1,101 @@
+package numerology
+
+import "strings"
+
+// ThaiChar is one written cluster of a name: a base consonant with its attached
+// vowel and tone marks, or a standalone vowel / non-Thai character.
+type ThaiChar struct {
+	Original  string
+	Consonant string
+	Vowel     string
+	ToneMark  string
+	IsThai    bool
+	IsLatin   bool
+	IsDigit   bool
+}
+
+// Components returns the non-empty parts that carry a numerology value, in lookup order.
+func (t ThaiChar) Components() []string {
+	var parts []string
+	for _, p := range []string{t.Consonant, t.Vowel, t.ToneMark} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return parts
+}
+
+// Helper functions for character classification
+func isThaiConsonant(r rune) bool { return r >= 'ก' && r <= 'ฮ' }
+func isLeadingVowel(r rune) bool {
+	return r == 'เ' || r == 'แ' || r == 'โ' || r == 'ใ' || r == 'ไ'
+}
+func isUpperLowerVowel(r rune) bool {
+	return r == '\u0E31' || (r >= '\u0E34' && r <= '\u0E37') || (r >= '\u0E38' && r <= '\u0E3A') || r == '\u0E47'
+}
+func isToneMark(r rune) bool      { return r >= '\u0E48' && r <= '\u0E4E' }
+func isThaiCharacter(r rune) bool { return r >= 'ก' && r <= '๛' }
+
+// DecodeName splits a name into ThaiChar clusters.
+func DecodeName(name string) []ThaiChar {
+	cleanedName := strings.TrimSpace(name)
+	var result []ThaiChar
+	runes := []rune(cleanedName)
+	i := 0
+	for i < len(runes) {
+		r := runes[i]
+		char := string(r)
+
+		if r == ' ' {
+			result = append(result, ThaiChar{Original: " ", IsThai: false})
+			i++
+			continue
+		}
+
+		// Handle leading vowels as their own character
+		if isLeadingVowel(r) {
+			result = append(result, ThaiChar{Original: char, Vowel: char, IsThai: true})
+			i++
+			continue
+		}
+
+		// Handle consonants and their attached marks
+		if isThaiConsonant(r) {
+			consonant := char
+			original := char
+			vowel := ""
+			tone := ""
+			i++ // Consume consonant
+
+			// Look ahead for upper/lower vowels and tones
+			for i < len(runes) {
+				nextRune := runes[i]
+				if isUpperLowerVowel(nextRune) {
+					vowel += string(nextRune)
+					original += string(nextRune)
+					i++
+				} else if isToneMark(nextRune) {
+					tone += string(nextRune)
+					original += string(nextRune)
+					i++
+				} else {
+					break // Not an attached mark, stop
+				}
+			}
+			result = append(result, ThaiChar{Original: original, Consonant: consonant, Vowel: vowel, ToneMark: tone, IsThai: true})
+			continue
+		}
+
+		// Handle other Thai characters (like standalone า, ะ, or special chars)
+		if isThaiCharacter(r) {
+			result = append(result, ThaiChar{Original: char, Vowel: char, IsThai: true})
+			i++
+			continue
+		}
+
+		// Handle non-Thai characters; Latin letters and digits carry their own values
+		result = append(result, ThaiChar{Original: char, IsThai: false, IsLatin: isLatinLetter(r), IsDigit: isDigit(r)})
+		i++
+	}
+	return result
+}
@@ -0,0 +1,500 @@
+package numerology
+
+import (
+	"numberniceic/internal/core/domain"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Days lists every birth day the klakini tables are keyed on, in display order.
+var Days = []string{"sunday", "monday", "tuesday", "wednesday1", "wednesday2", "thursday", "friday", "saturday"}
+
+// Categories lists the life categories shown in the analysis, in display order.
+var Categories = []string{"สุขภาพ", "การงาน", "การเงิน", "ความรัก"}
+
+var categoryColors = map[string]string{
+	"การงาน":  "#90CAF9",
+	"การเงิน": "#FFCC80",
+	"ความรัก": "#F48FB1",
+	"สุขภาพ":  "#80CBC4",
+}
+
+type ValueProvider interface {
+	GetValue(char string) (int, bool)
+}
+
+type KlakiniProvider interface {
+	IsKlakini(day string, r rune) bool
+}
+
+type PairProvider interface {
+	GetMeaning(pair string) (domain.NumberPairMeaning, bool)
+}
+
+type CategoryProvider interface {
+	GetCategories(pairNumber string) ([]string, bool)
+	GetKeywords(pairNumber string) []string
+	GetNumberType(pairNumber string) string
+}
+
+// Options tunes what Analyze computes beyond the core sums and pairs.
+type Options struct {
+	// AllDays fills KlakiniDays for every birth day, as stored in names_miracle.
+	AllDays bool
+}
+
+// Engine is the single implementation of sat/sha summing, pair splitting and scoring.
+type Engine struct {
+	satValues  ValueProvider
+	shaValues  ValueProvider
+	latinSat   ValueProvider
+	latinSha   ValueProvider
+	klakini    KlakiniProvider
+	taksa      TaksaProvider
+	pairs      PairProvider
+	categories CategoryProvider
+	rulesets   RulesetProvider
+
+	snapshotLock sync.Locker // Held while pinning, so a reload never lands half way
+	pinned       bool
+}
+
+func NewEngine(satValues, shaValues, latinSat, latinSha ValueProvider, klakini KlakiniProvider, taksa TaksaProvider, pairs PairProvider, categories CategoryProvider, rulesets RulesetProvider) *Engine {
+	return &Engine{
+		satValues:  satValues,
+		shaValues:  shaValues,
+		latinSat:   latinSat,
+		latinSha:   latinSha,
+		klakini:    klakini,
+		taksa:      taksa,
+		pairs:      pairs,
+		categories: categories,
+		rulesets:   rulesets,
+	}
+}
+
+// SetSnapshotLock makes every analysis read one consistent snapshot of the
+// providers, taken while holding lock. Providers offer it through Snapshot.
+func (e *Engine) SetSnapshotLock(lock sync.Locker) {
+	e.snapshotLock = lock
+}
+
+// pin returns a copy of the engine reading snapshots of its providers, so one
+// analysis never mixes data from before and after a reload.
+func (e *Engine) pin() *Engine {
+	if e.pinned || e.snapshotLock == nil {
+		return e
+	}
+	e.snapshotLock.Lock()
+	defer e.snapshotLock.Unlock()
+	p := *e
+	p.pinned = true
+	p.satValues = snapshot(e.satValues)
+	p.shaValues = snapshot(e.shaValues)
+	p.latinSat = snapshot(e.latinSat)
+	p.latinSha = snapshot(e.latinSha)
+	p.klakini = snapshot(e.klakini)
+	p.taksa = snapshot(e.taksa)
+	p.pairs = snapshot(e.pairs)
+	p.categories = snapshot(e.categories)
+	p.rulesets = snapshot(e.rulesets)
+	return &p
+}
+
+// WithLetters returns a copy of the engine whose sat, sha and klakini lookups go
+// through the given wrappers, to preview an edit of those tables before saving it.
+func (e *Engine) WithLetters(sat, sha func(ValueProvider) ValueProvider, klakini func(KlakiniProvider) KlakiniProvider) *Engine {
+	p := *e.pin()
+	p.pinned = true
+	p.satValues, p.shaValues, p.klakini = sat(p.satValues), sha(p.shaValues), klakini(p.klakini)
+	return &p
+}
+
+func snapshot[T any](p T) T {
+	if s, ok := any(p).(interface{ Snapshot() T }); ok {
+		return s.Snapshot()
+	}
+	return p
+}
+
+// Analyze decodes the name, sums its sat and sha values and scores the resulting pairs for the given day.
+func (e *Engine) Analyze(name, day string, opts Options) *domain.NameAnalysis {
+	e = e.pin()
+	var chars []domain.DecodedResult
+	var satTotal, shaTotal int
+
+	for _, part := range valueParts(name) {
+		satVal, shaVal, _ := e.values(part)
+		chars = append(chars, domain.DecodedResult{
+			Character:       part,
+			NumerologyValue: satVal,
+			ShadowValue:     shaVal,
+			IsKlakini:       e.hasKlakini(day, part),
+		})
+		satTotal += satVal
+		shaTotal += shaVal
+	}
+
+	result := e.AnalyzeTotals(name, day, satTotal, shaTotal, opts)
+	result.Chars = chars
+	result.Script = DetectScript(name)
+	result.PhoneticKey = PhoneticKey(name)
+	return result
+}
+
+// Validate returns an *UnmappedCharError when any character of name has no sat or
+// sha value, so callers can reject the name instead of scoring it as 0. Digits are
+// rejected too: a personal name cannot contain them.
+func (e *Engine) Validate(name string) error {
+	e = e.pin()
+	return e.validate(name, false)
+}
+
+// ValidateBrand is Validate for business names, where digits count at face value.
+func (e *Engine) ValidateBrand(name string) error {
+	e = e.pin()
+	return e.validate(name, true)
+}
+
+func (e *Engine) validate(name string, allowDigits bool) error {
+	var missing []string
+	seen := make(map[string]bool)
+	for _, thaiChar := range DecodeName(name) {
+		if thaiChar.IsDigit && allowDigits {
+			continue
+		}
+		if !thaiChar.IsThai && !thaiChar.IsLatin && strings.TrimSpace(thaiChar.Original) != "" {
+			if !seen[thaiChar.Original] {
+				seen[thaiChar.Original] = true
+				missing = append(missing, thaiChar.Original)
+			}
+		}
+	}
+	for _, part := range valueParts(name) {
+		if _, _, ok := e.values(part); !ok && !seen[part] {
+			seen[part] = true
+			missing = append(missing, part)
+		}
+	}
+	if len(missing) > 0 {
+		return &UnmappedCharError{Chars: missing}
+	}
+	return nil
+}
+
+// valueTables picks the Thai or Latin tables for part and the key it is stored under.
+func (e *Engine) valueTables(part string) (string, ValueProvider, ValueProvider) {
+	if r := []rune(part); len(r) == 1 && isLatinLetter(r[0]) {
+		return latinKey(part), e.latinSat, e.latinSha
+	}
+	return part, e.satValues, e.shaValues
+}
+
+// valueParts lists every part of name that carries a value: Thai consonants,
+// vowels and tone marks, Latin letters and digits.
+func valueParts(name string) []string {
+	var parts []string
+	for _, thaiChar := range DecodeName(name) {
+		switch {
+		case thaiChar.IsThai:
+			parts = append(parts, thaiChar.Components()...)
+		case thaiChar.IsLatin, thaiChar.IsDigit:
+			parts = append(parts, thaiChar.Original)
+		}
+	}
+	return parts
+}
+
+// values looks a part up in the Thai or Latin tables; ok is false when either value is missing.
+func (e *Engine) values(part string) (int, int, bool) {
+	if r := []rune(part); len(r) == 1 && isDigit(r[0]) {
+		// A digit is worth its face value in both pillars.
+		return int(r[0] - '0'), int(r[0] - '0'), true
+	}
+	key, satValues, shaValues := e.valueTables(part)
+	satVal, satOK := satValues.GetValue(key)
+	shaVal, shaOK := shaValues.GetValue(key)
+	return satVal, shaVal, satOK && shaOK
+}
+
+// AnalyzeFullName scores first name, surname and the combined full name separately.
+func (e *Engine) AnalyzeFullName(firstName, surname, day string, opts Options) *domain.FullNameAnalysis {
+	e = e.pin()
+	return e.WithSurname(e.Analyze(firstName, day, opts), surname, day, opts)
+}
+
+// WithSurname adds the surname and combined pillars to an existing first-name
+// analysis, which may have been built from stored sums. The combined pillar sums
+// both names, the same as scoring the full name as one string.
+func (e *Engine) WithSurname(first *domain.NameAnalysis, surname, day string, opts Options) *domain.FullNameAnalysis {
+	e = e.pin()
+	last := e.Analyze(surname, day, opts)
+	return &domain.FullNameAnalysis{First: first, Last: last, Combined: e.Combine(first, last, day, opts)}
+}
+
+// Combine scores the combined pillar of two analysed names. Callers scoring many
+// first names against one surname analyse the surname once and reuse it here.
+func (e *Engine) Combine(first, last *domain.NameAnalysis, day string, opts Options) *domain.NameAnalysis {
+	e = e.pin()
+	combined := e.AnalyzeTotals(JoinFullName(first.Name, last.Name), day, first.SatTotal+last.SatTotal, first.ShaTotal+last.ShaTotal, opts)
+	if len(first.Chars) > 0 || len(last.Chars) > 0 {
+		combined.Chars = append(append([]domain.DecodedResult{}, first.Chars...), last.Chars...)
+	}
+	return combined
+}
+
+// JoinFullName builds the display form of a full name.
+func JoinFullName(firstName, surname string) string {
+	if surname == "" {
+		return firstName
+	}
+	return firstName + " " + surname
+}
+
+// AnalyzeTotals scores already summed values, e.g. the sums stored with a saved name.
+func (e *Engine) AnalyzeTotals(name, day string, satTotal, shaTotal int, opts Options) *domain.NameAnalysis {
+	e = e.pin()
+	result := e.AnalyzePairs(name, day, SplitPairs(satTotal), SplitPairs(shaTotal), opts)
+	result.SatTotal = satTotal
+	result.ShaTotal = shaTotal
+	return result
+}
+
+// AnalyzePairs scores pairs that were split elsewhere, e.g. the satnum/shanum columns of names_miracle.
+func (e *Engine) AnalyzePairs(name, day string, satPairs, shaPairs []string, opts Options) *domain.NameAnalysis {
+	e = e.pin()
+	rs := e.Ruleset()
+	result := &domain.NameAnalysis{
+		Name:           name,
+		Day:            day,
+		SatNum:         satPairs,
+		ShaNum:         shaPairs,
+		RulesetVersion: rs.Version,
+	}
+
+	result.SatPairs, result.SatPositive, result.SatNegative = e.scorePairs(rs, satPairs)
+	result.ShaPairs, result.ShaPositive, result.ShaNegative = e.scorePairs(rs, shaPairs)
+	result.TSat = pairTypes(result.SatPairs)
+	result.TSha = pairTypes(result.ShaPairs)
+
+	badPairs := countBadPairs(rs, result.SatPairs) + countBadPairs(rs, result.ShaPairs)
+	result.HasBadPair = badPairs > 0
+	// Top tier: every pair of both pillars must be a known pair of a top-tier type.
+	result.IsTopTier = e.isStrictPremium(rs, satPairs) && e.isStrictPremium(rs, shaPairs)
+
+	result.KlakiniChars = e.KlakiniChars(name, day)
+	result.TotalScore = result.SatPositive + result.SatNegative + result.ShaPositive + result.ShaNegative -
+		badPairs*rs.Rules.Penalties.BadPair - len(result.KlakiniChars)*rs.Rules.Penalties.Klakini
+	result.DisplayChars = e.DisplayChars(name, day)
+	result.Taksa = e.Taksa(name, day)
+	result.LeadingTaksa = e.LeadingTaksa(name, day)
+	if opts.AllDays {
+		result.KlakiniDays = make(map[string]bool, len(Days))
+		for _, d := range Days {
+			result.KlakiniDays[d] = len(e.KlakiniChars(name, d)) > 0
+		}
+	}
+
+	result.CategoryCounts, result.CategoryBreakdown = e.categoryBreakdown(rs, append(append([]string{}, satPairs...), shaPairs...))
+	return result
+}
+
+// PairMeanings looks up pairs in number_pairs, applying the ruleset colours.
+func (e *Engine) PairMeanings(pairs []string) []domain.PairMeaningResult {
+	e = e.pin()
+	meanings, _, _ := e.scorePairs(e.Ruleset(), pairs)
+	return meanings
+}
+
+// KlakiniChars returns every character of name that is klakini for day.
+func (e *Engine) KlakiniChars(name, day string) []string {
+	e = e.pin()
+	var klakiniChars []string
+	for _, r := range name {
+		if e.klakini.IsKlakini(day, r) {
+			klakiniChars = append(klakiniChars, string(r))
+		}
+	}
+	return klakiniChars
+}
+
+// DisplayChars groups each base character with its combining marks and flags the
+// whole cluster as bad when any part of it is klakini for day.
+func (e *Engine) DisplayChars(name, day string) []domain.DisplayChar {
+	e = e.pin()
+	var result []domain.DisplayChar
+	runes := []rune(name)
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		currentStr := string(r)
+		isBad := e.klakini.IsKlakini(day, r)
+
+		for i+1 < len(runes) && isCombiningMark(runes[i+1]) {
+			i++
+			currentStr += string(runes[i])
+			if e.klakini.IsKlakini(day, runes[i]) {
+				isBad = true
+			}
+		}
+
+		result = append(result, domain.DisplayChar{Char: currentStr, IsBad: isBad})
+	}
+
+	return result
+}
+
+func isCombiningMark(r rune) bool {
+	return unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Mc, r) || unicode.Is(unicode.Me, r)
+}
+
+func (e *Engine) hasKlakini(day, s string) bool {
+	for _, r := range s {
+		if e.klakini.IsKlakini(day, r) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Engine) scorePairs(rs *domain.ScoringRuleset, pairs []string) ([]domain.PairMeaningResult, int, int) {
+	var meanings []domain.PairMeaningResult
+	var posScore, negScore int
+	for _, p := range pairs {
+		meaning, ok := e.pairs.GetMeaning(p)
+		if !ok {
+			continue
+		}
+		meaning.Color = rs.PairColor(meaning.PairType)
+		meaning.IsBad = rs.IsBad(meaning.PairType)
+		meanings = append(meanings, domain.PairMeaningResult{PairNumber: p, Meaning: meaning})
+		if meaning.PairPoint > 0 {
+			posScore += meaning.PairPoint
+		} else {
+			negScore += meaning.PairPoint
+		}
+	}
+	return meanings, posScore, negScore
+}
+
+func (e *Engine) isStrictPremium(rs *domain.ScoringRuleset, pairs []string) bool {
+	if len(pairs) == 0 {
+		return false
+	}
+	for _, p := range pairs {
+		m, ok := e.pairs.GetMeaning(p)
+		if !ok || !rs.IsTopTierType(m.PairType) {
+			return false
+		}
+	}
+	return true
+}
+
+func pairTypes(meanings []domain.PairMeaningResult) []domain.PairTypeInfo {
+	types := make([]domain.PairTypeInfo, len(meanings))
+	for i, m := range meanings {
+		types[i] = domain.PairTypeInfo{Type: m.Meaning.PairType, Color: m.Meaning.Color}
+	}
+	return types
+}
+
+func countBadPairs(rs *domain.ScoringRuleset, meanings []domain.PairMeaningResult) int {
+	count := 0
+	for _, m := range meanings {
+		if rs.IsBad(m.Meaning.PairType) {
+			count++
+		}
+	}
+	return count
+}
+
+// CategoryStrengths scores each category over the pairs as in
+// domain.CategoryStrengths, without the rest of the analysis.
+func (e *Engine) CategoryStrengths(satPairs, shaPairs []string) map[string]int {
+	e = e.pin()
+	_, breakdown := e.categoryBreakdown(e.Ruleset(), append(append([]string{}, satPairs...), shaPairs...))
+	return domain.CategoryStrengths(breakdown)
+}
+
+// categoryBreakdown counts how often each category appears across the pairs and
+// collects its good and bad keywords. Good/bad follows the pair type, the same
+// source of truth as the score.
+func (e *Engine) categoryBreakdown(rs *domain.ScoringRuleset, pairs []string) (map[string]int, map[string]domain.CategoryBreakdown) {
+	counts := make(map[string]int, len(Categories))
+	breakdown := make(map[string]domain.CategoryBreakdown, len(Categories))
+	goodKeywords := make(map[string]map[string]bool, len(Categories))
+	badKeywords := make(map[string]map[string]bool, len(Categories))
+	for _, cat := range Categories {
+		counts[cat] = 0
+		breakdown[cat] = domain.CategoryBreakdown{Color: categoryColors[cat]}
+		goodKeywords[cat] = make(map[string]bool)
+		badKeywords[cat] = make(map[string]bool)
+	}
+
+	for _, pairNumber := range pairs {
+		cats, ok := e.categories.GetCategories(pairNumber)
+		if !ok {
+			continue
+		}
+		keywords := e.categories.GetKeywords(pairNumber)
+
+		var isGood, isBad bool
+		if m, ok := e.pairs.GetMeaning(pairNumber); ok {
+			isGood = rs.IsGood(m.PairType)
+			isBad = rs.IsBad(m.PairType)
+		} else {
+			// Fallback to category cache if pair meaning missing (unlikely)
+			numberType := e.categories.GetNumberType(pairNumber)
+			isGood = numberType == "ดี"
+			isBad = numberType == "ร้าย"
+		}
+
+		for _, c := range cats {
+			current, allowed := breakdown[c]
+			if !allowed {
+				continue
+			}
+			counts[c]++
+			if isGood {
+				current.Good++
+			} else if isBad {
+				current.Bad++
+			}
+			for _, kw := range keywords {
+				if kw == "" {
+					continue
+				}
+				if isGood {
+					goodKeywords[c][kw] = true
+				} else if isBad {
+					badKeywords[c][kw] = true
+				}
+			}
+			breakdown[c] = current
+		}
+	}
+
+	for _, cat := range Categories {
+		entry := breakdown[cat]
+		good := sortedKeys(goodKeywords[cat])
+		entry.BadKeywords = sortedKeys(badKeywords[cat])
+		// Keywords carries good and bad together because the mobile app only reads
+		// this field and colours entries using BadKeywords.
+		entry.Keywords = make([]string, 0, len(good)+len(entry.BadKeywords))
+		entry.Keywords = append(entry.Keywords, good...)
+		entry.Keywords = append(entry.Keywords, entry.BadKeywords...)
+		breakdown[cat] = entry
+	}
+	return counts, breakdown
+}
+
+func sortedKeys(set map[string]bool) []string {
+	var keys []string
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
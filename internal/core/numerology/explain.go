@@ -0,0 +1,65 @@
+package numerology
+
+import "numberniceic/internal/core/domain"
+
+// Explain traces every lookup Analyze performs for name, including values missing
+// from the caches and how each total was split into pairs.
+func (e *Engine) Explain(name string) *domain.AnalysisTrace {
+	e = e.pin()
+	trace := &domain.AnalysisTrace{Name: name, Clusters: []domain.ClusterTrace{}, Missing: []string{}}
+
+	for _, thaiChar := range DecodeName(name) {
+		cluster := domain.ClusterTrace{
+			Original:   thaiChar.Original,
+			Consonant:  thaiChar.Consonant,
+			Vowel:      thaiChar.Vowel,
+			ToneMark:   thaiChar.ToneMark,
+			IsThai:     thaiChar.IsThai,
+			IsLatin:    thaiChar.IsLatin,
+			Components: []domain.ComponentTrace{},
+		}
+
+		var parts, roles []string
+		switch {
+		case thaiChar.IsThai:
+			for _, p := range []struct{ value, role string }{
+				{thaiChar.Consonant, "consonant"},
+				{thaiChar.Vowel, "vowel"},
+				{thaiChar.ToneMark, "tone_mark"},
+			} {
+				if p.value != "" {
+					parts = append(parts, p.value)
+					roles = append(roles, p.role)
+				}
+			}
+		case thaiChar.IsLatin:
+			parts, roles = []string{thaiChar.Original}, []string{"latin"}
+		}
+
+		for i, part := range parts {
+			key, satValues, shaValues := e.valueTables(part)
+			satVal, satOK := satValues.GetValue(key)
+			shaVal, shaOK := shaValues.GetValue(key)
+			trace.SatTotal += satVal
+			trace.ShaTotal += shaVal
+			if !satOK || !shaOK {
+				trace.Missing = append(trace.Missing, part)
+			}
+			cluster.Components = append(cluster.Components, domain.ComponentTrace{
+				Character:  part,
+				Role:       roles[i],
+				SatValue:   satVal,
+				ShaValue:   shaVal,
+				SatFound:   satOK,
+				ShaFound:   shaOK,
+				SatRunning: trace.SatTotal,
+				ShaRunning: trace.ShaTotal,
+			})
+		}
+		trace.Clusters = append(trace.Clusters, cluster)
+	}
+
+	trace.SatSplit = domain.PairSplitTrace{Total: trace.SatTotal, Method: SplitMethod(trace.SatTotal), Pairs: SplitPairs(trace.SatTotal)}
+	trace.ShaSplit = domain.PairSplitTrace{Total: trace.ShaTotal, Method: SplitMethod(trace.ShaTotal), Pairs: SplitPairs(trace.ShaTotal)}
+	return trace
+}
@@ -0,0 +1,255 @@
+package numerology
+
+import (
+	"numberniceic/internal/core/domain"
+	"sort"
+	"strings"
+)
+
+// maxSpellingVariants bounds the combinations explored for long names.
+const maxSpellingVariants = 1000
+
+// finalSounds groups the ways a syllable ending can be written for each final
+// sound, including endings carried by silent (์) letters. The empty ending covers
+// open syllables, which may take a silent letter without changing the sound.
+var finalSounds = [][]string{
+	{"", "ห์", "ร์", "ส์", "ย์"},
+	{"น", "ณ", "ญ", "ร", "ล", "ฬ", "นต์", "นท์", "นด์", "นทร์", "นส์", "ณฑ์", "ณต์", "ญจน์", "รณ์", "ลย์"},
+	{"ด", "ต", "ท", "ธ", "ฎ", "ฏ", "ฐ", "ฑ", "ฒ", "ถ", "จ", "ช", "ซ", "ศ", "ษ", "ส", "ตร์", "ทร์", "ษฐ์", "ชญ์"},
+	{"ก", "ข", "ค", "ฆ", "คร์", "กษ์", "ค์"},
+	{"บ", "ป", "พ", "ภ", "ฟ", "พธ์", "ปต์"},
+	{"ม", "มน์", "มย์"},
+}
+
+// spellingSlot is one part of the name that can be written in several ways.
+type spellingSlot struct {
+	start, end int // Cluster range [start, end) replaced by an option
+	options    []string
+}
+
+// SpellingVariants writes name in every way that keeps its pronunciation, scores
+// each spelling for day and returns them best first. The input spelling is always
+// included and flagged, so callers can see whether another spelling beats it.
+func (e *Engine) SpellingVariants(name, day string, limit int) []domain.SpellingVariant {
+	e = e.pin()
+	clusters := DecodeName(name)
+	slots := spellingSlots(clusters)
+
+	var variants []domain.SpellingVariant
+	seen := make(map[string]bool)
+	original := strings.TrimSpace(name)
+
+	choice := make([]string, len(slots))
+	var walk func(i int)
+	walk = func(i int) {
+		if len(seen) >= maxSpellingVariants {
+			return
+		}
+		if i == len(slots) {
+			variant := assembleSpelling(clusters, slots, choice)
+			if seen[variant] {
+				return
+			}
+			seen[variant] = true
+			variants = append(variants, e.spellingVariant(variant, day, variant == original))
+			return
+		}
+		for _, opt := range slots[i].options {
+			choice[i] = opt
+			walk(i + 1)
+		}
+	}
+	walk(0)
+
+	if !seen[original] {
+		variants = append(variants, e.spellingVariant(original, day, true))
+	}
+
+	sort.SliceStable(variants, func(i, j int) bool {
+		a, b := variants[i], variants[j]
+		if (len(a.KlakiniChars) == 0) != (len(b.KlakiniChars) == 0) {
+			return len(a.KlakiniChars) == 0
+		}
+		if a.HasBadPair != b.HasBadPair {
+			return !a.HasBadPair
+		}
+		if a.IsTopTier != b.IsTopTier {
+			return a.IsTopTier
+		}
+		return a.TotalScore > b.TotalScore
+	})
+
+	if limit > 0 && len(variants) > limit {
+		// Keep the input spelling visible even when it falls outside the limit.
+		trimmed := variants[:limit]
+		for _, v := range variants[limit:] {
+			if v.IsOriginal {
+				trimmed = append(trimmed, v)
+				break
+			}
+		}
+		variants = trimmed
+	}
+	return variants
+}
+
+func (e *Engine) spellingVariant(name, day string, isOriginal bool) domain.SpellingVariant {
+	result := e.Analyze(name, day, Options{})
+	return domain.SpellingVariant{
+		Name:         name,
+		IsOriginal:   isOriginal,
+		SatNum:       result.SatNum,
+		ShaNum:       result.ShaNum,
+		TSat:         result.TSat,
+		TSha:         result.TSha,
+		SatPairs:     result.SatPairInfos(),
+		ShaPairs:     result.ShaPairInfos(),
+		TotalScore:   result.TotalScore,
+		HasBadPair:   result.HasBadPair,
+		IsTopTier:    result.IsTopTier,
+		KlakiniChars: result.KlakiniChars,
+		DisplayChars: result.DisplayChars,
+	}
+}
+
+// spellingSlots finds the initial consonants and syllable endings that have
+// interchangeable spellings.
+func spellingSlots(clusters []ThaiChar) []spellingSlot {
+	var slots []spellingSlot
+	for i := 0; i < len(clusters); i++ {
+		c := clusters[i]
+		if !c.IsThai {
+			continue
+		}
+
+		if endsOpenSyllable(clusters, i) {
+			end := silentTailEnd(clusters, i+1)
+			tail := joinOriginals(clusters[i+1 : end])
+			if opts := soundGroup(tail); opts != nil {
+				slots = append(slots, spellingSlot{start: i + 1, end: end, options: opts})
+				i = end - 1
+				continue
+			}
+		}
+
+		if isFinalConsonant(clusters, i) {
+			end := silentTailEnd(clusters, i+1)
+			ending := joinOriginals(clusters[i:end])
+			if opts := soundGroup(ending); opts != nil {
+				slots = append(slots, spellingSlot{start: i, end: end, options: opts})
+				i = end - 1
+				continue
+			}
+		}
+
+		if c.Consonant != "" {
+			if alts := sameClassAlternatives(c.Consonant); len(alts) > 0 {
+				opts := []string{c.Original}
+				for _, alt := range alts {
+					opts = append(opts, alt+c.Vowel+c.ToneMark)
+				}
+				slots = append(slots, spellingSlot{start: i, end: i + 1, options: opts})
+			}
+		}
+	}
+	return slots
+}
+
+// sameClassAlternatives returns the letters that can replace an initial consonant
+// without changing its sound or, since the class sets the tone, its tone. ซ and ฮ
+// are low class and do not swap with ศ/ษ/ส and ห.
+func sameClassAlternatives(consonant string) []string {
+	var alts []string
+	for _, alt := range alternatives(consonantGroups, consonant) {
+		if consonantClass[alt] == consonantClass[consonant] {
+			alts = append(alts, alt)
+		}
+	}
+	return alts
+}
+
+// endsOpenSyllable reports whether cluster i closes a syllable without a final
+// consonant: a trailing vowel at the end of a word, optionally followed by silent letters.
+func endsOpenSyllable(clusters []ThaiChar, i int) bool {
+	c := clusters[i]
+	if c.Consonant != "" || c.Vowel == "" || isLeadingVowelCluster(c) {
+		return false
+	}
+	end := silentTailEnd(clusters, i+1)
+	return end == len(clusters) || !clusters[end].IsThai
+}
+
+func isLeadingVowelCluster(c ThaiChar) bool {
+	return c.Consonant == "" && c.Vowel != "" && isLeadingVowel([]rune(c.Vowel)[0])
+}
+
+// isFinalConsonant reports whether cluster i is a bare consonant that closes the
+// syllable started before it.
+func isFinalConsonant(clusters []ThaiChar, i int) bool {
+	c := clusters[i]
+	if i == 0 || c.Consonant == "" || c.Vowel != "" || c.ToneMark != "" {
+		return false
+	}
+	prev := clusters[i-1]
+	if !prev.IsThai {
+		return false
+	}
+	if prev.Consonant == "" {
+		// Trailing vowel such as า; a leading vowel belongs to this consonant instead.
+		return !isLeadingVowelCluster(prev)
+	}
+	if prev.Vowel != "" {
+		return true
+	}
+	// เดช: the consonant after a leading vowel + initial closes the syllable.
+	return i >= 2 && isLeadingVowelCluster(clusters[i-2])
+}
+
+// silentTailEnd returns the end of the run of silent letters starting at i:
+// bare consonants followed by a consonant carrying ์.
+func silentTailEnd(clusters []ThaiChar, i int) int {
+	for j := i; j < len(clusters); j++ {
+		c := clusters[j]
+		if c.Consonant == "" {
+			return i
+		}
+		if strings.Contains(c.ToneMark, silentMark) {
+			return j + 1
+		}
+		if c.Vowel != "" || c.ToneMark != "" {
+			return i
+		}
+	}
+	return i
+}
+
+func soundGroup(spelling string) []string {
+	for _, group := range finalSounds {
+		for _, g := range group {
+			if g == spelling {
+				return group
+			}
+		}
+	}
+	return nil
+}
+
+func joinOriginals(clusters []ThaiChar) string {
+	var sb strings.Builder
+	for _, c := range clusters {
+		sb.WriteString(c.Original)
+	}
+	return sb.String()
+}
+
+func assembleSpelling(clusters []ThaiChar, slots []spellingSlot, choice []string) string {
+	var sb strings.Builder
+	next := 0
+	for i, slot := range slots {
+		sb.WriteString(joinOriginals(clusters[next:slot.start]))
+		sb.WriteString(choice[i])
+		next = slot.end
+	}
+	sb.WriteString(joinOriginals(clusters[next:]))
+	return strings.TrimSpace(sb.String())
+}
@@ -0,0 +1,64 @@
+package numerology
+
+import (
+	"numberniceic/internal/core/domain"
+	"strings"
+	"unicode"
+)
+
+// Name endings that mark a Thai given name as usually given to girls or boys.
+// Names matching neither (or both) are treated as suitable for anyone.
+var (
+	femaleEndings = []string{
+		"ณี", "นี", "ดา", "ลดา", "ธิดา", "สุดา", "นภา", "ประภา", "มาลี", "มาลัย", "วรรณ", "วรรณา",
+		"ทิพย์", "ทิพา", "ศรี", "ฤดี", "รดี", "ภรณ์", "ภรณ", "นันท์", "ลักษณ์", "จันทร์", "พรรณ",
+		"พรรณี", "ริน", "อร", "ปรียา", "กานต์", "กานดา", "ชนก", "ชนิกา", "รัตนา", "ธิมา",
+	}
+	maleEndings = []string{
+		"ชัย", "ศักดิ์", "พงษ์", "พงศ์", "วุฒิ", "เดช", "ชาย", "วัฒน์", "ยุทธ", "พล", "ณรงค์", "ศักย์",
+		"ฤทธิ์", "วิทย์", "กร", "ภพ", "ภูมิ", "ธร", "เกียรติ", "สิทธิ์", "ชาติ", "ศร", "นนท์", "ธนา",
+		"บดี", "เทพ", "วีร์", "กฤษณ์", "ศิษฏ์",
+	}
+)
+
+// NameGender guesses who a given name usually suits from its ending: the longest
+// matching ending wins, so ธิดา reads as female even though it ends in ดา.
+func NameGender(name string) string {
+	name = strings.TrimSpace(name)
+	best, gender := 0, domain.GenderUnisex
+	match := func(endings []string, g string) {
+		for _, e := range endings {
+			if n := len([]rune(e)); n > best && strings.HasSuffix(name, e) {
+				best, gender = n, g
+			} else if n == best && strings.HasSuffix(name, e) && gender != g {
+				gender = domain.GenderUnisex
+			}
+		}
+	}
+	match(femaleEndings, domain.GenderFemale)
+	match(maleEndings, domain.GenderMale)
+	return gender
+}
+
+// SyllableCount counts the spoken syllables of a name, as read by PhoneticKey.
+func SyllableCount(name string) int {
+	return len(strings.FieldsFunc(PhoneticKey(name), func(r rune) bool { return r == '-' || r == ' ' }))
+}
+
+// FirstLetter returns the first consonant of a name, skipping a leading vowel, so
+// เปรม and ปรีชา both start with ป. Latin letters are upper-cased.
+func FirstLetter(name string) string {
+	for _, r := range name {
+		switch {
+		case isThaiConsonant(r):
+			return string(r)
+		case isLatinLetter(r):
+			return string(unicode.ToUpper(r))
+		case isLeadingVowel(r) || r == ' ':
+			continue
+		default:
+			return string(r)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,59 @@
+package numerology
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// SplitPairs turns a sat/sha total into the pairs that are read from it.
+// Single digits are zero padded, odd lengths use overlapping pairs and even
+// lengths are read two digits at a time.
+func SplitPairs(total int) []string {
+	s := strconv.Itoa(total)
+	if total < 0 {
+		return []string{}
+	}
+	if len(s) < 2 {
+		return []string{fmt.Sprintf("%02d", total)}
+	}
+	if len(s) == 2 {
+		return []string{s}
+	}
+
+	var pairs []string
+	if len(s)%2 != 0 {
+		for i := 0; i < len(s)-1; i++ {
+			pairs = append(pairs, s[i:i+2])
+		}
+	} else {
+		for i := 0; i < len(s); i += 2 {
+			pairs = append(pairs, s[i:i+2])
+		}
+	}
+	return pairs
+}
+
+// Ways SplitPairs reads a total, reported by the analysis trace.
+const (
+	SplitPadded      = "padded"      // single digit, zero padded
+	SplitWhole       = "whole"       // two digits read as one pair
+	SplitOverlapping = "overlapping" // odd length, every neighbouring pair
+	SplitSequential  = "sequential"  // even length, two digits at a time
+)
+
+// SplitMethod names the rule SplitPairs applies to total.
+func SplitMethod(total int) string {
+	n := len(strconv.Itoa(total))
+	switch {
+	case total < 0:
+		return ""
+	case n < 2:
+		return SplitPadded
+	case n == 2:
+		return SplitWhole
+	case n%2 != 0:
+		return SplitOverlapping
+	default:
+		return SplitSequential
+	}
+}
@@ -0,0 +1,314 @@
+package numerology
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Thai tones, as the last digit of a syllable in a phonetic key.
+const (
+	ToneMid = iota
+	ToneLow
+	ToneFalling
+	ToneHigh
+	ToneRising
+)
+
+// Consonant classes decide the tone together with the tone mark and syllable type.
+const (
+	classMid  = "mid"
+	classHigh = "high"
+	classLow  = "low"
+)
+
+var consonantClass = map[string]string{}
+
+// initialSounds and finalConsonantSounds group letters that are pronounced the same,
+// e.g. ณ and น are both "n", and ฐ, ท and ต all end a syllable as "t".
+var initialSounds = map[string]string{}
+var finalConsonantSounds = map[string]string{}
+
+func init() {
+	for class, letters := range map[string]string{
+		classMid:  "กจฎฏดตบปอ",
+		classHigh: "ขฃฉฐถผฝศษสห",
+		classLow:  "คฅฆงชซฌญฑฒณทธนพฟภมยรลวฬฮ",
+	} {
+		for _, r := range letters {
+			consonantClass[string(r)] = class
+		}
+	}
+	for sound, letters := range map[string]string{
+		"k": "ก", "kh": "ขฃคฅฆ", "ng": "ง", "c": "จ", "ch": "ฉชฌ", "s": "ซศษส", "y": "ญย",
+		"d": "ฎด", "t": "ฏต", "th": "ฐฑฒถทธ", "n": "ณน", "b": "บ", "p": "ป", "ph": "ผพภ",
+		"f": "ฝฟ", "m": "ม", "r": "ร", "l": "ลฬ", "w": "ว", "h": "หฮ", "'": "อ",
+	} {
+		for _, r := range letters {
+			initialSounds[string(r)] = sound
+		}
+	}
+	for sound, letters := range map[string]string{
+		"k": "กขคฆ", "ng": "ง", "t": "จชซฌฎฏฐฑฒดตถทธศษส", "n": "ญณนรลฬ",
+		"p": "บปพฟภ", "m": "ม", "y": "ย", "w": "ว",
+	} {
+		for _, r := range letters {
+			finalConsonantSounds[string(r)] = sound
+		}
+	}
+}
+
+// phoneticSyllable is one spoken syllable of a Thai name.
+type phoneticSyllable struct {
+	initial string // Initial consonant sound, with a cluster's second sound (e.g. "pr")
+	class   string
+	vowel   string // Vowel quality, e.g. "a", "ae", "ai"
+	long    bool
+	final   string // Final consonant sound, "" for an open syllable
+	mark    string // Written tone mark, if any
+}
+
+func (s phoneticSyllable) tone() int {
+	live := s.final == "n" || s.final == "m" || s.final == "ng" || s.final == "y" || s.final == "w" ||
+		(s.final == "" && (s.long || s.vowel == "am" || s.vowel == "ai" || s.vowel == "ao"))
+	switch s.mark {
+	case "่": // Mai ek
+		if s.class == classLow {
+			return ToneFalling
+		}
+		return ToneLow
+	case "้": // Mai tho
+		if s.class == classLow {
+			return ToneHigh
+		}
+		return ToneFalling
+	case "๊": // Mai tri
+		return ToneHigh
+	case "๋": // Mai chattawa
+		return ToneRising
+	}
+	switch {
+	case s.class == classHigh && live:
+		return ToneRising
+	case live:
+		return ToneMid
+	case s.class == classLow && s.long:
+		return ToneFalling
+	case s.class == classLow:
+		return ToneHigh
+	default:
+		return ToneLow
+	}
+}
+
+func (s phoneticSyllable) code() string {
+	var b strings.Builder
+	b.WriteString(s.initial)
+	b.WriteString(s.vowel)
+	if s.long {
+		b.WriteString(":")
+	}
+	b.WriteString(s.final)
+	b.WriteString(strconv.Itoa(s.tone()))
+	return b.String()
+}
+
+// PhoneticKey describes how a Thai name sounds: one code per syllable made of the
+// initial consonant sound, the vowel and its length, the final consonant sound
+// and the tone (0 mid to 4 rising). Names spelled differently but pronounced the
+// same share a key, e.g. ณัฐ and นัท are both "nat3". Syllables are joined by "-"
+// and words by " "; Latin words are kept as lower-case letters.
+func PhoneticKey(name string) string {
+	var words []string
+	for _, word := range strings.Fields(name) {
+		if syllables := phoneticSyllables(DecodeName(word)); len(syllables) > 0 {
+			words = append(words, strings.Join(syllables, "-"))
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// phoneticSyllables reads the clusters of one word. Thai spelling does not mark
+// syllable breaks, so this follows the common patterns of given names: a plain
+// consonant after a syllable closes it unless a trailing vowel (า ะ ำ) follows.
+func phoneticSyllables(clusters []ThaiChar) []string {
+	var codes []string
+	var latin strings.Builder
+	flushLatin := func() {
+		if latin.Len() > 0 {
+			codes = append(codes, latin.String())
+			latin.Reset()
+		}
+	}
+
+	n := len(clusters)
+	for i := 0; i < n; {
+		c := clusters[i]
+		if !c.IsThai {
+			latin.WriteString(strings.ToLower(c.Original))
+			i++
+			continue
+		}
+		flushLatin()
+		if isSilent(c) || (c.Consonant == "" && !isLeadingVowelCluster(c)) {
+			i++ // Silenced letters and stray vowels carry no syllable of their own
+			continue
+		}
+		if isPlainConsonant(c) && i+1 < n && isSilent(clusters[i+1]) && clusters[i+1].Consonant == "ร" {
+			i += 2 // The silencing mark on ร silences the whole cluster, as in จันทร์
+			continue
+		}
+
+		var s phoneticSyllable
+		lead := ""
+		if isLeadingVowelCluster(c) {
+			lead = c.Original
+			i++
+			if i >= n || clusters[i].Consonant == "" {
+				s.vowel, s.long = vowelQuality(lead, "")
+				s.initial, s.class = "'", classMid
+				codes = append(codes, s.code())
+				continue
+			}
+			c = clusters[i]
+		}
+		i++
+
+		s.initial, s.class = initialSounds[c.Consonant], consonantClass[c.Consonant]
+		marks, mark := c.Vowel, toneOf(c)
+
+		if marks == "" && mark == "" && i < n && clusters[i].Consonant != "" && !isSilent(clusters[i]) {
+			next := clusters[i]
+			// After a leading vowel the pair shares it (เปรม, ไหม) unless the second letter
+			// has a vowel of its own (ไพลิน); otherwise the pair needs a vowel to follow.
+			nextHasVowel := next.Vowel != "" || next.ToneMark != "" || (i+1 < n && isTrailingVowel(clusters[i+1]))
+			if lead != "" {
+				nextHasVowel = next.Vowel == ""
+			}
+			switch {
+			case c.Consonant == "ห" && strings.Contains("งญนมยรลว", next.Consonant) && nextHasVowel,
+				c.Consonant == "อ" && next.Consonant == "ย" && nextHasVowel:
+				// ห and อ lead a low sonorant: its sound, their class
+				s.initial = initialSounds[next.Consonant]
+				marks, mark = next.Vowel, toneOf(next)
+				i++
+			case strings.Contains("กขคตปผพ", c.Consonant) && strings.Contains("รลว", next.Consonant) && nextHasVowel:
+				s.initial += initialSounds[next.Consonant]
+				marks, mark = next.Vowel, toneOf(next)
+				i++
+			}
+		}
+
+		trailing := ""
+		for i < n && isTrailingVowel(clusters[i]) {
+			trailing += clusters[i].Original
+			i++
+		}
+		s.vowel, s.long = vowelQuality(lead, marks+trailing)
+
+		open := s.vowel == "am" || s.vowel == "ai" || s.vowel == "ao"
+		if !open && i < n && isPlainConsonant(clusters[i]) && !(i+1 < n && isTrailingVowel(clusters[i+1])) {
+			final := clusters[i].Consonant
+			i++
+			if final == "อ" && lead == "" && marks+trailing == "" {
+				s.vowel, s.long = "or", true
+			} else {
+				s.final = finalConsonantSounds[final]
+				// A cluster ending in ร ends the word silently after a written vowel (ภัทร, เพชร, จักร)
+				if i+1 == n && lead+marks+trailing != "" && isPlainConsonant(clusters[i]) && clusters[i].Consonant == "ร" {
+					i++
+					if lead == "เ" && marks+trailing == "" {
+						s.long = false // เพชร and เมตร read short, as เพ็ด and เม็ด
+					}
+				}
+			}
+		}
+		s.mark = mark
+		codes = append(codes, s.code())
+	}
+	flushLatin()
+	return codes
+}
+
+func isSilent(c ThaiChar) bool { return strings.Contains(c.ToneMark, "์") }
+
+func isPlainConsonant(c ThaiChar) bool {
+	return c.Consonant != "" && c.Vowel == "" && c.ToneMark == ""
+}
+
+func isTrailingVowel(c ThaiChar) bool {
+	return c.IsThai && c.Consonant == "" && strings.Contains("าะำๅ", c.Original)
+}
+
+// toneOf returns the tone mark of a cluster, ignoring the silencing mark.
+func toneOf(c ThaiChar) string {
+	for _, r := range c.ToneMark {
+		if r >= '่' && r <= '๋' {
+			return string(r)
+		}
+	}
+	return ""
+}
+
+// vowelQuality names the vowel written with a leading vowel and the marks after
+// the consonant, and reports whether it is long.
+func vowelQuality(lead, marks string) (string, bool) {
+	short := strings.ContainsAny(marks, "ะัิึุ็")
+	switch lead {
+	case "เ":
+		switch {
+		case strings.Contains(marks, "า"):
+			return "ao", false
+		case strings.Contains(marks, "ี"):
+			return "ia", true
+		case strings.Contains(marks, "ื"):
+			return "uea", true
+		}
+		return "e", !short
+	case "แ":
+		return "ae", !short
+	case "โ":
+		return "o", !short
+	case "ใ", "ไ":
+		return "ai", false
+	}
+	switch {
+	case strings.Contains(marks, "ำ"):
+		return "am", false
+	case strings.ContainsAny(marks, "ิี"):
+		return "i", strings.Contains(marks, "ี")
+	case strings.ContainsAny(marks, "ึื"):
+		return "ue", strings.Contains(marks, "ื")
+	case strings.ContainsAny(marks, "ุู"):
+		return "u", strings.Contains(marks, "ู")
+	case strings.ContainsAny(marks, "ะัา"):
+		return "a", strings.Contains(marks, "า")
+	}
+	return "o", false // Inherent vowel
+}
+
+// PhoneticSimilarityKeys returns the comparison keys of a phonetic key: each
+// syllable, each syllable without its tone, and each pair of neighbouring
+// syllables, so names that share sounds in the same order score highest.
+func PhoneticSimilarityKeys(key string) []string {
+	syllables := strings.FieldsFunc(key, func(r rune) bool { return r == '-' || r == ' ' })
+	if len(syllables) == 0 {
+		return nil
+	}
+	seen := make(map[string]bool)
+	var keys []string
+	add := func(k string) {
+		if !seen[k] {
+			seen[k] = true
+			keys = append(keys, k)
+		}
+	}
+	prev := "^"
+	for _, s := range syllables {
+		add("s:" + s)
+		add("r:" + strings.TrimRight(s, "01234"))
+		add("b:" + prev + "|" + s)
+		prev = s
+	}
+	add("b:" + prev + "|$")
+	return keys
+}
@@ -0,0 +1,41 @@
+package numerology
+
+import "testing"
+
+func TestPhoneticKeySilentR(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       string
+		homophone string
+	}{
+		{"ภัทร", "phat3", "พัด"},
+		{"มิตร", "mit3", "มิด"},
+		{"เพชร", "phet3", "เพ็ด"},
+		{"บุตร", "but1", "บุด"},
+		{"จักร", "cak1", "จัก"},
+		{"จันทร์", "can0", "จัน"},
+		{"อินทร์", "'in0", "อิน"},
+		{"สุภัทร", "su1-phat3", "สุพัด"},
+		// ร that starts a syllable of its own is still read
+		{"ภัทรา", "phat3-ra:0", ""},
+		{"สุนทร", "sun4-thon0", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PhoneticKey(tt.name); got != tt.key {
+				t.Errorf("PhoneticKey(%q) = %q, want %q", tt.name, got, tt.key)
+			}
+			if tt.homophone != "" && PhoneticKey(tt.homophone) != tt.key {
+				t.Errorf("PhoneticKey(%q) = %q, want the key of %q", tt.homophone, PhoneticKey(tt.homophone), tt.name)
+			}
+		})
+	}
+}
+
+func TestSyllableCountSilentR(t *testing.T) {
+	for name, want := range map[string]int{"ภัทร": 1, "จันทร์": 1, "สมุทร": 2, "ภัทรา": 2} {
+		if got := SyllableCount(name); got != want {
+			t.Errorf("SyllableCount(%q) = %d, want %d", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package numerology
+
+import (
+	"errors"
+	"numberniceic/internal/core/domain"
+	"regexp"
+	"strings"
+)
+
+// ErrInvalidPlate is returned for text that is not a Thai licence plate.
+var ErrInvalidPlate = errors.New("รูปแบบทะเบียนรถไม่ถูกต้อง ตัวอย่าง: 1กข 9999 หรือ กข 1234")
+
+// platePattern matches an optional leading digit, one to three Thai letters, a
+// one to four digit number and an optional province, with optional spaces or a dash.
+var platePattern = regexp.MustCompile(`^([0-9]?)\s*([ก-ฮ]{1,3})\s*-?\s*([0-9]{1,4})(?:\s+(\p{Thai}[\p{Thai}\s]*))?$`)
+
+// ParsePlate splits a plate into its parts. It does not look any value up.
+func ParsePlate(plate string) (*domain.PlateAnalysis, error) {
+	m := platePattern.FindStringSubmatch(strings.Join(strings.Fields(plate), " "))
+	if m == nil {
+		return nil, ErrInvalidPlate
+	}
+	p := &domain.PlateAnalysis{Prefix: m[1], Letters: m[2], Number: m[3], Province: strings.TrimSpace(m[4])}
+	p.Plate = p.Prefix + p.Letters + " " + p.Number
+	return p, nil
+}
+
+// AnalyzePlate sums the sat value of every plate letter and the face value of
+// every digit, reads the sum's pairs from number_pairs and flags letters that are
+// klakini for the owner's birth day.
+func (e *Engine) AnalyzePlate(plate, day string) (*domain.PlateAnalysis, error) {
+	e = e.pin()
+	p, err := ParsePlate(plate)
+	if err != nil {
+		return nil, err
+	}
+	p.Day = day
+
+	var missing []string
+	for _, r := range p.Prefix + p.Letters + p.Number {
+		char := string(r)
+		value, ok := int(r-'0'), true
+		if !isDigit(r) {
+			value, ok = e.satValues.GetValue(char)
+		}
+		if !ok {
+			missing = append(missing, char)
+			continue
+		}
+		p.Chars = append(p.Chars, domain.DecodedResult{
+			Character:       char,
+			NumerologyValue: value,
+			IsKlakini:       e.klakini.IsKlakini(day, r),
+		})
+		p.Sum += value
+	}
+	if len(missing) > 0 {
+		return nil, &UnmappedCharError{Chars: missing}
+	}
+
+	rs := e.Ruleset()
+	var pos, neg int
+	p.SumPairs, pos, neg = e.scorePairs(rs, SplitPairs(p.Sum))
+	badPairs := countBadPairs(rs, p.SumPairs)
+	p.HasBadPair = badPairs > 0
+	p.KlakiniChars = e.KlakiniChars(p.Letters, day)
+	p.TotalScore = pos + neg - badPairs*rs.Rules.Penalties.BadPair - len(p.KlakiniChars)*rs.Rules.Penalties.Klakini
+	return p, nil
+}
@@ -0,0 +1,179 @@
+package numerology
+
+import (
+	"numberniceic/internal/core/domain"
+	"sort"
+	"strings"
+)
+
+// Characters that can stand in for each other without changing how a name is read.
+var consonantGroups = [][]string{
+	{"ศ", "ษ", "ส"},
+	{"ท", "ธ", "ฑ", "ฒ"},
+	{"ค", "ฆ"},
+	{"ข", "ฃ"},
+	{"ช", "ฌ"},
+	{"ซ", "ศ", "ษ", "ส"},
+	{"ต", "ฏ"},
+	{"ด", "ฎ"},
+	{"ถ", "ฐ"},
+	{"พ", "ภ"},
+	{"ย", "ญ"},
+	{"น", "ณ"},
+	{"ล", "ฬ"},
+	{"ฮ", "ห"},
+}
+
+// Vowels are only swapped within the same written position so the variant stays readable.
+var vowelGroups = [][]string{
+	{"ิ", "ี"},
+	{"ึ", "ื"},
+	{"ุ", "ู"},
+	{"ั", "็"},
+	{"ใ", "ไ"},
+	{"เ", "แ"},
+	{"ะ", "า"},
+}
+
+var toneMarks = []string{"่", "้", "๊", "๋"}
+
+const silentMark = "์"
+
+// repairEdit is one candidate single-character change.
+type repairEdit struct {
+	kind     string
+	position int
+	from, to string
+	cluster  string
+}
+
+// Repair explores single-character edits of name and returns the variants that have
+// no bad pair and no klakini for day, best first. The original name is never returned.
+func (e *Engine) Repair(name, day string, limit int) []domain.NameRepair {
+	e = e.pin()
+	clusters := DecodeName(name)
+	seen := map[string]bool{strings.TrimSpace(name): true}
+
+	var repairs []domain.NameRepair
+	for _, edit := range repairEdits(clusters) {
+		parts := make([]string, len(clusters))
+		for i, c := range clusters {
+			parts[i] = c.Original
+		}
+		parts[edit.position] = edit.cluster
+		variant := strings.Join(parts, "")
+		if seen[variant] {
+			continue
+		}
+		seen[variant] = true
+
+		result := e.Analyze(variant, day, Options{})
+		if result.HasBadPair || len(result.KlakiniChars) > 0 {
+			continue
+		}
+		repairs = append(repairs, domain.NameRepair{
+			Name:         variant,
+			EditKind:     edit.kind,
+			Position:     edit.position,
+			From:         edit.from,
+			To:           edit.to,
+			SatNum:       result.SatNum,
+			ShaNum:       result.ShaNum,
+			TSat:         result.TSat,
+			TSha:         result.TSha,
+			TotalScore:   result.TotalScore,
+			IsTopTier:    result.IsTopTier,
+			DisplayChars: result.DisplayChars,
+		})
+	}
+
+	sort.SliceStable(repairs, func(i, j int) bool {
+		if repairs[i].IsTopTier != repairs[j].IsTopTier {
+			return repairs[i].IsTopTier
+		}
+		return repairs[i].TotalScore > repairs[j].TotalScore
+	})
+	if limit > 0 && len(repairs) > limit {
+		repairs = repairs[:limit]
+	}
+	return repairs
+}
+
+// repairEdits lists every single-character edit of the clusters, in a stable order.
+func repairEdits(clusters []ThaiChar) []repairEdit {
+	var edits []repairEdit
+	for i, c := range clusters {
+		if !c.IsThai {
+			continue
+		}
+
+		if c.Consonant == "" {
+			// Leading or trailing vowel standing on its own.
+			for _, alt := range alternatives(vowelGroups, c.Vowel) {
+				edits = append(edits, repairEdit{kind: domain.RepairVowelSwap, position: i, from: c.Vowel, to: alt, cluster: alt})
+			}
+			continue
+		}
+
+		build := func(consonant, vowel, tone string) string { return consonant + vowel + tone }
+
+		for _, alt := range alternatives(consonantGroups, c.Consonant) {
+			edits = append(edits, repairEdit{kind: domain.RepairConsonantSwap, position: i, from: c.Consonant, to: alt, cluster: build(alt, c.Vowel, c.ToneMark)})
+		}
+
+		for _, alt := range alternatives(vowelGroups, c.Vowel) {
+			edits = append(edits, repairEdit{kind: domain.RepairVowelSwap, position: i, from: c.Vowel, to: alt, cluster: build(c.Consonant, alt, c.ToneMark)})
+		}
+
+		switch {
+		case c.ToneMark == "":
+			for _, t := range toneMarks {
+				edits = append(edits, repairEdit{kind: domain.RepairToneAdd, position: i, to: t, cluster: build(c.Consonant, c.Vowel, t)})
+			}
+			// A silent mark only makes sense on the bare consonant ending a word.
+			if i > 0 && c.Vowel == "" && isWordEnd(clusters, i) && !isLeadingVowelCluster(clusters[i-1]) {
+				edits = append(edits, repairEdit{kind: domain.RepairSilentAdd, position: i, to: silentMark, cluster: build(c.Consonant, "", silentMark)})
+			}
+		case c.ToneMark == silentMark:
+			edits = append(edits, repairEdit{kind: domain.RepairSilentRemove, position: i, from: silentMark, cluster: build(c.Consonant, c.Vowel, "")})
+		default:
+			edits = append(edits, repairEdit{kind: domain.RepairToneRemove, position: i, from: c.ToneMark, cluster: build(c.Consonant, c.Vowel, "")})
+			for _, t := range alternatives([][]string{toneMarks}, c.ToneMark) {
+				edits = append(edits, repairEdit{kind: domain.RepairToneSwap, position: i, from: c.ToneMark, to: t, cluster: build(c.Consonant, c.Vowel, t)})
+			}
+		}
+	}
+	return edits
+}
+
+func isWordEnd(clusters []ThaiChar, i int) bool {
+	return i == len(clusters)-1 || !clusters[i+1].IsThai
+}
+
+// alternatives returns the other members of every group that contains s.
+func alternatives(groups [][]string, s string) []string {
+	if s == "" {
+		return nil
+	}
+	var alts []string
+	seen := map[string]bool{s: true}
+	for _, group := range groups {
+		found := false
+		for _, g := range group {
+			if g == s {
+				found = true
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+		for _, g := range group {
+			if !seen[g] {
+				seen[g] = true
+				alts = append(alts, g)
+			}
+		}
+	}
+	return alts
+}
@@ -0,0 +1,42 @@
+package numerology
+
+import "numberniceic/internal/core/domain"
+
+// RulesetProvider serves the scoring ruleset that is currently active.
+type RulesetProvider interface {
+	Active() *domain.ScoringRuleset
+}
+
+// DefaultRuleset is the built-in ruleset (version 0) used before any version is
+// stored or when the ruleset table cannot be read. It matches the seeded version 1.
+func DefaultRuleset() *domain.ScoringRuleset {
+	return &domain.ScoringRuleset{
+		Version: 0,
+		Name:    "Built-in",
+		Rules: domain.ScoringRules{
+			Tiers: []domain.PairTier{
+				{PairType: "D10", Class: domain.PairClassGood, Color: "#2E7D32"},
+				{PairType: "D8", Class: domain.PairClassGood, Color: "#43A047"},
+				{PairType: "D5", Class: domain.PairClassGood, Color: "#66BB6A"},
+				{PairType: "R10", Class: domain.PairClassBad, Color: "#C62828"},
+				{PairType: "R7", Class: domain.PairClassBad, Color: "#E53935"},
+				{PairType: "R5", Class: domain.PairClassBad, Color: "#EF5350"},
+			},
+			BadPairColor:     "#D32F2F",
+			UnknownPairColor: "#9E9E9E",
+			TopTierTypes:     []string{"D10", "D8", "D5"},
+		},
+		IsActive: true,
+	}
+}
+
+// Ruleset returns the ruleset currently used for scoring.
+func (e *Engine) Ruleset() *domain.ScoringRuleset {
+	e = e.pin()
+	if e.rulesets != nil {
+		if rs := e.rulesets.Active(); rs != nil {
+			return rs
+		}
+	}
+	return DefaultRuleset()
+}
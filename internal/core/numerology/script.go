@@ -0,0 +1,53 @@
+package numerology
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Scripts a name can be written in.
+const (
+	ScriptThai  = "thai"
+	ScriptLatin = "latin"
+	ScriptMixed = "mixed"
+	ScriptNone  = ""
+)
+
+func isLatinLetter(r rune) bool { return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') }
+func isDigit(r rune) bool       { return r >= '0' && r <= '9' }
+
+// DetectScript reports which script the letters of name are written in. Spaces
+// and other characters are ignored.
+func DetectScript(name string) string {
+	var hasThai, hasLatin bool
+	for _, r := range name {
+		switch {
+		case isThaiCharacter(r):
+			hasThai = true
+		case isLatinLetter(r):
+			hasLatin = true
+		}
+	}
+	switch {
+	case hasThai && hasLatin:
+		return ScriptMixed
+	case hasThai:
+		return ScriptThai
+	case hasLatin:
+		return ScriptLatin
+	default:
+		return ScriptNone
+	}
+}
+
+// latinKey is the char_key a Latin letter is stored under in latin_sat_nums/latin_sha_nums.
+func latinKey(s string) string { return strings.ToUpper(s) }
+
+// UnmappedCharError lists characters of a name that have no sat or sha value.
+type UnmappedCharError struct {
+	Chars []string
+}
+
+func (e *UnmappedCharError) Error() string {
+	return fmt.Sprintf("ไม่พบค่าตัวเลขของตัวอักษร: %s", strings.Join(e.Chars, ", "))
+}
@@ -0,0 +1,92 @@
+package numerology
+
+import "strings"
+
+// GraphemeClusters splits a name into what a reader sees as one letter: a
+// consonant with its marks, with a leading vowel (เ แ โ ใ ไ) kept together with
+// the consonant it is written before. Latin letters are lower-cased, and spaces
+// are returned as " " so callers can split words.
+func GraphemeClusters(name string) []string {
+	var clusters []string
+	pending := ""
+	for _, c := range DecodeName(name) {
+		switch {
+		case c.Original == " ":
+			if pending != "" {
+				clusters = append(clusters, pending)
+				pending = ""
+			}
+			clusters = append(clusters, " ")
+		case c.IsThai && c.Consonant == "" && isLeadingVowel([]rune(c.Original)[0]):
+			if pending != "" {
+				clusters = append(clusters, pending)
+			}
+			pending = c.Original
+		default:
+			clusters = append(clusters, pending+strings.ToLower(c.Original))
+			pending = ""
+		}
+	}
+	if pending != "" {
+		clusters = append(clusters, pending)
+	}
+	return clusters
+}
+
+// SimilarityKeys returns the distinct cluster trigrams of a name, the Thai-aware
+// counterpart of pg_trgm's trigrams: each word is padded with two blanks in front
+// and one behind, so short names and shared first letters still count.
+func SimilarityKeys(name string) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	word := []string{" ", " "}
+	flush := func() {
+		if len(word) == 2 {
+			return
+		}
+		word = append(word, " ")
+		for i := 0; i+3 <= len(word); i++ {
+			key := strings.Join(word[i:i+3], "\x00")
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+		word = []string{" ", " "}
+	}
+	for _, c := range GraphemeClusters(name) {
+		if c == " " {
+			flush()
+			continue
+		}
+		word = append(word, c)
+	}
+	flush()
+	return keys
+}
+
+// KeySimilarity is the share of trigrams two names have in common, from 0 to 1,
+// given the number of shared keys and the key counts of both names.
+func KeySimilarity(shared, a, b int) float64 {
+	union := a + b - shared
+	if union == 0 {
+		return 0
+	}
+	return float64(shared) / float64(union)
+}
+
+// NameSimilarity compares two names with SimilarityKeys.
+func NameSimilarity(a, b string) float64 {
+	ka, kb := SimilarityKeys(a), SimilarityKeys(b)
+	set := make(map[string]bool, len(ka))
+	for _, k := range ka {
+		set[k] = true
+	}
+	shared := 0
+	for _, k := range kb {
+		if set[k] {
+			shared++
+		}
+	}
+	return KeySimilarity(shared, len(ka), len(kb))
+}
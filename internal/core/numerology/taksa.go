@@ -0,0 +1,67 @@
+package numerology
+
+import (
+	"numberniceic/internal/core/domain"
+	"sort"
+)
+
+// TaksaBoost is added to the similarity of a suggested name whose leading
+// letter is in the เดช or ศรี group of the birth day.
+const TaksaBoost = 0.1
+
+type TaksaProvider interface {
+	TaksaGroup(day string, r rune) (string, bool)
+}
+
+// Taksa returns the Taksa group of every letter of name for day. Tone marks and
+// other characters outside the eight letter groups are left out.
+func (e *Engine) Taksa(name, day string) []domain.TaksaLetter {
+	e = e.pin()
+	if e.taksa == nil || day == "" {
+		return nil
+	}
+	var letters []domain.TaksaLetter
+	for _, r := range name {
+		if group, ok := e.taksa.TaksaGroup(day, r); ok {
+			letters = append(letters, domain.TaksaLetter{Char: string(r), Group: group})
+		}
+	}
+	return letters
+}
+
+// LeadingTaksa is the Taksa group of the first sounded letter of name: a leading
+// vowel (เ แ โ ใ ไ) is skipped in favour of the consonant it is written before.
+func (e *Engine) LeadingTaksa(name, day string) string {
+	e = e.pin()
+	if e.taksa == nil || day == "" {
+		return ""
+	}
+	runes := []rune(name)
+	for i, r := range runes {
+		if isLeadingVowel(r) && i+1 < len(runes) {
+			continue
+		}
+		group, _ := e.taksa.TaksaGroup(day, r)
+		return group
+	}
+	return ""
+}
+
+// IsFavouredTaksa reports whether group is one a name should ideally start with.
+func IsFavouredTaksa(group string) bool {
+	return group == domain.TaksaDet || group == domain.TaksaSri
+}
+
+// BoostLeadingTaksa reorders suggestions by similarity, adding TaksaBoost for
+// names whose leading letter is in a favoured group. LeadingTaksa must be set.
+func BoostLeadingTaksa(names []domain.SimilarNameResult) {
+	boosted := func(n domain.SimilarNameResult) float64 {
+		if IsFavouredTaksa(n.LeadingTaksa) {
+			return n.Similarity + TaksaBoost
+		}
+		return n.Similarity
+	}
+	sort.SliceStable(names, func(i, j int) bool {
+		return boosted(names[i]) > boosted(names[j])
+	})
+}
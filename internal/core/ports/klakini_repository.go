@@ -5,4 +5,6 @@ import "numberniceic/internal/core/domain"
 type KlakiniRepository interface {
 	GetAll() ([]domain.Klakini, error)
 	GetByDay(day string) (domain.Klakini, error)
+	// Save sets the bad characters of k.Day, inserting the day when it has no row.
+	Save(k domain.Klakini) error
 }
@@ -12,6 +12,7 @@ type MemberRepository interface {
 	Delete(id int) error
 
 	UpdateDayOfBirth(id int, dayOfWeek int) error
+	UpdateBirthMoment(id int, moment *domain.BirthMoment) error
 
 	// Admin methods
 	GetAllMembers() ([]domain.Member, error)
@@ -0,0 +1,29 @@
+package ports
+
+import "numberniceic/internal/core/domain"
+
+// NameDictionaryRepository stores the curated name-meaning dictionary.
+type NameDictionaryRepository interface {
+	GetAll() ([]domain.NameDictionaryEntry, error)
+	// Search matches query against term and meaning; empty query and status match everything.
+	Search(query, status string, limit int) ([]domain.NameDictionaryEntry, error)
+	GetByID(id int) (*domain.NameDictionaryEntry, error)
+	Create(entry *domain.NameDictionaryEntry) error
+	Update(entry *domain.NameDictionaryEntry) error
+	Delete(id int) error
+	// Upsert inserts the entry or replaces the one with the same term and kind.
+	Upsert(entry *domain.NameDictionaryEntry) error
+	// CreateIfMissing inserts the entry unless its term and kind exist, reporting whether it did.
+	CreateIfMissing(entry *domain.NameDictionaryEntry) (bool, error)
+}
+
+// NameDictionaryProvider serves the dictionary from memory.
+type NameDictionaryProvider interface {
+	// Lookup returns the entry for term of the first of kinds that has one, or nil.
+	Lookup(term string, kinds ...string) *domain.NameDictionaryEntry
+	// MaxPartClusters is the length, in grapheme clusters, of the longest root or syllable.
+	MaxPartClusters() int
+	// Add serves new entries without reloading the whole dictionary.
+	Add(entries ...domain.NameDictionaryEntry)
+	Reload() error
+}
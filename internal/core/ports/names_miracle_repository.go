@@ -5,12 +5,16 @@ import "numberniceic/internal/core/domain"
 // NamesMiracleRepository defines the port for interacting with the names_miracle data.
 type NamesMiracleRepository interface {
 	// GetSimilarNames now accepts an allowKlakini flag to conditionally filter.
-	GetSimilarNames(name, day string, limit, offset int, allowKlakini bool) ([]domain.SimilarNameResult, error)
+	// opts picks the ranking and the metadata filters and sort.
+	GetSimilarNames(name, day string, limit, offset int, allowKlakini bool, opts domain.NameSearchOptions) ([]domain.SimilarNameResult, error)
 	GetBestSimilarNames(name, day string, limit int, allowKlakini bool) ([]domain.SimilarNameResult, error)
-	GetAuspiciousNames(name, preferredConsonant, day string, limit, offset int, allowKlakini, findGoodOnly bool) ([]domain.SimilarNameResult, error)
+	GetAuspiciousNames(name, preferredConsonant, day string, limit, offset int, allowKlakini, findGoodOnly bool, opts domain.NameSearchOptions) ([]domain.SimilarNameResult, error)
 	GetFallbackNames(name, preferredConsonant, day string, limit int, allowKlakini bool, excludedIDs []int) ([]domain.SimilarNameResult, error)
 	Create(name *domain.SimilarNameResult) error
 	GetLatest(limit int) ([]domain.SimilarNameResult, error)
 	Delete(id int) error
 	Count() (int, error)
+	// GetBatch and UpdateComputed let the recompute job walk the table in name_id order.
+	GetBatch(afterID, limit int) ([]domain.SimilarNameResult, error)
+	UpdateComputed(name *domain.SimilarNameResult) error
 }
@@ -4,4 +4,14 @@ import "numberniceic/internal/core/domain"
 
 type NumberCategoryRepository interface {
 	GetAll() ([]domain.NumberCategory, error)
+	GetByID(id int) (*domain.NumberCategory, error)
+	Create(c *domain.NumberCategory) error
+	Update(c *domain.NumberCategory) error
+	Delete(id int) error
+}
+
+// NumberCategoryProvider serves the categories of each pair from memory.
+type NumberCategoryProvider interface {
+	GetCategories(pairNumber string) ([]string, bool)
+	Reload() error
 }
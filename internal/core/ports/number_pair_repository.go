@@ -4,4 +4,16 @@ import "numberniceic/internal/core/domain"
 
 type NumberPairRepository interface {
 	GetAll() ([]domain.NumberPairMeaning, error)
+	// List returns the numbers rows alone, without their categories, ordered by pair.
+	List() ([]domain.NumberPairMeaning, error)
+	GetByPair(pairNumber string) (*domain.NumberPairMeaning, error)
+	// Save updates the row for m.PairNumber, inserting it when there is none.
+	Save(m *domain.NumberPairMeaning) error
+	Delete(pairNumber string) error
+}
+
+// NumberPairProvider serves pair meanings from memory.
+type NumberPairProvider interface {
+	GetMeaning(pairNumber string) (domain.NumberPairMeaning, bool)
+	Reload() error
 }
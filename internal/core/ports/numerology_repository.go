@@ -6,4 +6,9 @@ import "numberniceic/internal/core/domain"
 // It's a generic interface for fetching character-to-value mappings.
 type NumerologyRepository interface {
 	GetAll() ([]domain.Numerology, error)
+	// GetByChar returns nil when the table has no row for char.
+	GetByChar(char string) (*domain.Numerology, error)
+	// Save sets the value of n.Character, inserting the row when there is none.
+	Save(n domain.Numerology) error
+	Delete(char string) error
 }
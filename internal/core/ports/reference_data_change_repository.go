@@ -0,0 +1,10 @@
+package ports
+
+import "numberniceic/internal/core/domain"
+
+// ReferenceDataChangeRepository keeps the history of admin edits to reference tables.
+type ReferenceDataChangeRepository interface {
+	Record(change *domain.ReferenceDataChange) error
+	// List returns the newest changes first; an empty recordKey matches every record of the table.
+	List(tableName, recordKey string, limit int) ([]domain.ReferenceDataChange, error)
+}
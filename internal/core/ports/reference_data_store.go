@@ -0,0 +1,25 @@
+package ports
+
+// ReferenceDataTx is the editable reference tables and their change history,
+// read and written through one connection or transaction.
+type ReferenceDataTx interface {
+	Pairs() NumberPairRepository
+	Categories() NumberCategoryRepository
+	Changes() ReferenceDataChangeRepository
+	// Letters is the letter value table named table, e.g. sat_nums.
+	Letters(table string) NumerologyRepository
+	Klakini() KlakiniRepository
+}
+
+// ReferenceDataStore reads the reference tables and runs an edit together with
+// the record of it in one transaction.
+type ReferenceDataStore interface {
+	ReferenceDataTx
+	// InTx commits when fn returns nil and rolls back otherwise.
+	InTx(fn func(tx ReferenceDataTx) error) error
+}
+
+// CacheRefresher reloads the caches built from tables and swaps them in together.
+type CacheRefresher interface {
+	Refresh(tables ...string) error
+}
@@ -6,3 +6,8 @@ type SampleNamesRepository interface {
 	GetAll() ([]domain.SampleName, error)
 	SetActive(id int) error
 }
+
+// SampleNameProvider serves the sample names from memory.
+type SampleNameProvider interface {
+	GetAll() ([]domain.SampleName, error)
+}
@@ -0,0 +1,9 @@
+package ports
+
+import "numberniceic/internal/core/domain"
+
+type SavedCoupleRepository interface {
+	Save(couple *domain.SavedCouple) error
+	GetByUserID(userID int) ([]domain.SavedCouple, error)
+	Delete(id int, userID int) error
+}
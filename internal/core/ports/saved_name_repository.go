@@ -5,5 +5,6 @@ import "numberniceic/internal/core/domain"
 type SavedNameRepository interface {
 	Save(savedName *domain.SavedName) error
 	GetByUserID(userID int) ([]domain.SavedName, error)
+	GetAll() ([]domain.SavedName, error)
 	Delete(id int, userID int) error
 }
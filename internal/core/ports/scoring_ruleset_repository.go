@@ -0,0 +1,16 @@
+package ports
+
+import "numberniceic/internal/core/domain"
+
+type ScoringRulesetRepository interface {
+	GetActive() (*domain.ScoringRuleset, error)
+	GetAll() ([]domain.ScoringRuleset, error)
+	Create(ruleset *domain.ScoringRuleset) error
+	Activate(version int) error
+}
+
+// ScoringRulesetProvider serves the active ruleset from memory.
+type ScoringRulesetProvider interface {
+	Active() *domain.ScoringRuleset
+	Reload() error
+}
@@ -0,0 +1,7 @@
+package ports
+
+import "numberniceic/internal/core/domain"
+
+type TaksaRepository interface {
+	GetAll() ([]domain.Taksa, error)
+}
@@ -51,7 +51,10 @@ func (s *AdminService) GetSellNumbersPaged(page, pageSize int) (domain.PagedPhon
 }
 
 func (s *AdminService) AddSystemName(name string) error {
-	details := s.numerologySvc.CalculateNameDetails(name)
+	details, err := s.numerologySvc.CalculateNameDetails(name)
+	if err != nil {
+		return err
+	}
 	return s.namesMiracleRepo.Create(details)
 }
 
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/numerology"
+	"numberniceic/internal/core/ports"
+	"strconv"
+	"strings"
+)
+
+// ConsistencyService compares stored analyses (names_miracle rows and saved
+// names) with what the live engine computes, and explains each difference.
+type ConsistencyService struct {
+	namesRepo ports.NamesMiracleRepository
+	savedRepo ports.SavedNameRepository
+	engine    *numerology.Engine
+}
+
+func NewConsistencyService(namesRepo ports.NamesMiracleRepository, savedRepo ports.SavedNameRepository, engine *numerology.Engine) *ConsistencyService {
+	return &ConsistencyService{namesRepo: namesRepo, savedRepo: savedRepo, engine: engine}
+}
+
+// Verify checks every names_miracle row, batchSize rows at a time, and every saved name.
+func (s *ConsistencyService) Verify(batchSize int) (*domain.ConsistencyReport, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultRecomputeBatchSize
+	}
+	report := &domain.ConsistencyReport{
+		RulesetVersion: s.engine.Ruleset().Version,
+		ByCause:        make(map[string]int),
+	}
+
+	afterID := 0
+	for {
+		rows, err := s.namesRepo.GetBatch(afterID, batchSize)
+		if err != nil {
+			return report, err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		for i := range rows {
+			afterID = rows[i].NameID
+			report.CheckedNamesMiracle++
+			s.record(report, s.checkNamesMiracle(&rows[i]))
+		}
+	}
+
+	saved, err := s.savedRepo.GetAll()
+	if err != nil {
+		return report, err
+	}
+	for i := range saved {
+		report.CheckedSavedNames++
+		s.record(report, s.checkSavedName(&saved[i]))
+	}
+	return report, nil
+}
+
+// record adds the mismatches of one row, counting each cause once per row.
+func (s *ConsistencyService) record(report *domain.ConsistencyReport, mismatches []domain.ConsistencyMismatch) {
+	seen := make(map[string]bool)
+	for _, m := range mismatches {
+		if !seen[m.Cause] {
+			seen[m.Cause] = true
+			report.ByCause[m.Cause]++
+		}
+	}
+	report.Mismatches = append(report.Mismatches, mismatches...)
+}
+
+func (s *ConsistencyService) checkNamesMiracle(row *domain.SimilarNameResult) []domain.ConsistencyMismatch {
+	mismatch := func(cause, field, stored, live string) domain.ConsistencyMismatch {
+		return domain.ConsistencyMismatch{Source: domain.SourceNamesMiracle, ID: row.NameID, Name: row.ThName, Cause: cause, Field: field, Stored: stored, Live: live}
+	}
+	if err := s.engine.Validate(row.ThName); err != nil {
+		return []domain.ConsistencyMismatch{mismatch(domain.MismatchMissingMapping, "thname", row.ThName, err.Error())}
+	}
+
+	live := s.engine.Analyze(row.ThName, "", numerology.Options{AllDays: true}).ToSimilarNameResult()
+	changes := diffNamesMiracle(row, live)
+	changed := make(map[string]bool, len(changes))
+	for _, c := range changes {
+		changed[c.Column] = true
+	}
+
+	var mismatches []domain.ConsistencyMismatch
+	for _, c := range changes {
+		cause := domain.MismatchKlakini
+		switch c.Column {
+		case "phonetic_key", "gender", "syllables", "first_letter", "category_strengths":
+			continue // Search metadata, not part of the analysis; cmd/recompute_names backfills it
+		case "satnum", "shanum":
+			cause = domain.MismatchValueChange
+		case "t_sat", "t_sha":
+			// Pair types follow the pairs; only a type change on the same pairs is a meaning change.
+			if changed["satnum"] && c.Column == "t_sat" || changed["shanum"] && c.Column == "t_sha" {
+				continue
+			}
+			cause = domain.MismatchPairMeaning
+		}
+		mismatches = append(mismatches, mismatch(cause, c.Column, c.Before, c.After))
+	}
+	return mismatches
+}
+
+func (s *ConsistencyService) checkSavedName(sn *domain.SavedName) []domain.ConsistencyMismatch {
+	mismatch := func(cause, field string, stored, live int) domain.ConsistencyMismatch {
+		return domain.ConsistencyMismatch{Source: domain.SourceSavedNames, ID: sn.ID, Name: sn.Name, Day: sn.BirthDay, Cause: cause, Field: field, Stored: strconv.Itoa(stored), Live: strconv.Itoa(live)}
+	}
+	if err := s.engine.Validate(sn.Name); err != nil {
+		return []domain.ConsistencyMismatch{{Source: domain.SourceSavedNames, ID: sn.ID, Name: sn.Name, Day: sn.BirthDay, Cause: domain.MismatchMissingMapping, Field: "name", Stored: sn.Name, Live: err.Error()}}
+	}
+
+	// Saved names store the first-name sums and score shown by the analyzer.
+	live := s.engine.Analyze(sn.Name, strings.ToLower(sn.BirthDay), numerology.Options{})
+	var mismatches []domain.ConsistencyMismatch
+	if live.SatTotal != sn.SatSum {
+		mismatches = append(mismatches, mismatch(domain.MismatchValueChange, "sat_sum", sn.SatSum, live.SatTotal))
+	}
+	if live.ShaTotal != sn.ShaSum {
+		mismatches = append(mismatches, mismatch(domain.MismatchValueChange, "sha_sum", sn.ShaSum, live.ShaTotal))
+	}
+	if live.TotalScore != sn.TotalScore {
+		cause := domain.MismatchValueChange
+		if len(mismatches) == 0 {
+			cause = s.scoreCause(sn, live)
+		}
+		mismatches = append(mismatches, mismatch(cause, "total_score", sn.TotalScore, live.TotalScore))
+	}
+	return mismatches
+}
+
+// scoreCause explains a score difference on unchanged sums. Saved names do not
+// store their klakini letters, so a klakini change is inferred: the difference
+// must be a whole number of klakini penalties that leaves a non-negative letter count.
+func (s *ConsistencyService) scoreCause(sn *domain.SavedName, live *domain.NameAnalysis) string {
+	rs := s.engine.Ruleset()
+	if sn.RulesetVersion != rs.Version {
+		return domain.MismatchRuleset
+	}
+	penalty := rs.Rules.Penalties.Klakini
+	if penalty > 0 {
+		pairScore := live.TotalScore + len(live.KlakiniChars)*penalty
+		diff := pairScore - sn.TotalScore
+		if diff >= 0 && diff%penalty == 0 && diff/penalty != len(live.KlakiniChars) {
+			return domain.MismatchKlakini
+		}
+	}
+	return domain.MismatchPairMeaning
+}
@@ -0,0 +1,353 @@
+package service
+
+import (
+	"fmt"
+	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/numerology"
+	"numberniceic/internal/core/ports"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Tables whose edits are recorded by LetterDataService.
+const (
+	SatNumsTable  = "sat_nums"
+	ShaNumsTable  = "sha_nums"
+	KakisDayTable = "kakis_day"
+)
+
+// letterPreviewSamples is how many sample names a letter preview scores.
+const letterPreviewSamples = 8
+
+// LetterDataService edits the Thai letter values (sat_nums, sha_nums) and the
+// klakini letters of each day (kakis_day), records every edit and previews how
+// an edit changes the sample names.
+type LetterDataService struct {
+	store   ports.ReferenceDataStore
+	caches  ports.CacheRefresher
+	engine  *numerology.Engine
+	samples ports.SampleNameProvider
+}
+
+func NewLetterDataService(store ports.ReferenceDataStore, caches ports.CacheRefresher, engine *numerology.Engine, samples ports.SampleNameProvider) *LetterDataService {
+	return &LetterDataService{store: store, caches: caches, engine: engine, samples: samples}
+}
+
+// thaiAlphabet lists the Thai consonants, vowels and marks in Unicode order.
+func thaiAlphabet() []string {
+	var letters []string
+	for r := 'ก'; r <= '๎'; r++ {
+		if r > 'ฺ' && r < 'เ' {
+			continue // Unassigned and the baht sign
+		}
+		letters = append(letters, string(r))
+	}
+	return letters
+}
+
+// Letters returns the grid of the whole Thai alphabet, followed by any other
+// key the letter tables hold.
+func (s *LetterDataService) Letters() ([]domain.LetterValue, error) {
+	sat, err := s.store.Letters(SatNumsTable).GetAll()
+	if err != nil {
+		return nil, err
+	}
+	sha, err := s.store.Letters(ShaNumsTable).GetAll()
+	if err != nil {
+		return nil, err
+	}
+	klakini, err := s.store.Klakini().GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	alphabet := thaiAlphabet()
+	chars := alphabet
+	byChar := make(map[string]*domain.LetterValue, len(chars))
+	for _, c := range alphabet {
+		byChar[c] = &domain.LetterValue{Char: c}
+	}
+	letter := func(c string) *domain.LetterValue {
+		lv, ok := byChar[c]
+		if !ok {
+			lv = &domain.LetterValue{Char: c}
+			byChar[c] = lv
+			chars = append(chars, c)
+		}
+		return lv
+	}
+	for _, n := range sat {
+		v := n.Value
+		letter(n.Character).SatValue = &v
+	}
+	for _, n := range sha {
+		v := n.Value
+		letter(n.Character).ShaValue = &v
+	}
+
+	for _, day := range numerology.Days {
+		for _, k := range klakini {
+			if k.Day != day {
+				continue
+			}
+			for _, r := range k.BadChars {
+				lv := letter(string(r))
+				if !lv.IsKlakiniOn(day) {
+					lv.KlakiniDays = append(lv.KlakiniDays, day)
+				}
+			}
+		}
+	}
+
+	sort.Strings(chars[len(alphabet):])
+
+	letters := make([]domain.LetterValue, len(chars))
+	for i, c := range chars {
+		letters[i] = *byChar[c]
+	}
+	return letters, nil
+}
+
+// GetLetter returns the grid entry of char.
+func (s *LetterDataService) GetLetter(char string) (*domain.LetterValue, error) {
+	letters, err := s.Letters()
+	if err != nil {
+		return nil, err
+	}
+	for _, lv := range letters {
+		if lv.Char == char {
+			return &lv, nil
+		}
+	}
+	return nil, fmt.Errorf("letter %q: %w", char, ErrNumberDataNotFound)
+}
+
+// validateLetter trims the letter and keeps only known days, in display order.
+func validateLetter(lv *domain.LetterValue) error {
+	lv.Char = strings.TrimSpace(lv.Char)
+	if err := lv.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidNumberData, err)
+	}
+	var days []string
+	for _, day := range numerology.Days {
+		if lv.IsKlakiniOn(day) {
+			days = append(days, day)
+		}
+	}
+	lv.KlakiniDays = days
+	return nil
+}
+
+// SaveLetter stores the values and klakini days of the letter, recording one
+// change per table row it alters, in one transaction. The caches of the three
+// tables are then reloaded together.
+func (s *LetterDataService) SaveLetter(lv *domain.LetterValue, changedBy int) error {
+	if err := validateLetter(lv); err != nil {
+		return err
+	}
+	err := s.store.InTx(func(tx ports.ReferenceDataTx) error {
+		if err := saveLetterValue(tx, SatNumsTable, lv.Char, lv.SatValue, changedBy); err != nil {
+			return err
+		}
+		if err := saveLetterValue(tx, ShaNumsTable, lv.Char, lv.ShaValue, changedBy); err != nil {
+			return err
+		}
+		return saveKlakiniDays(tx, lv, changedBy)
+	})
+	if err != nil {
+		return err
+	}
+	return s.caches.Refresh(SatNumsTable, ShaNumsTable, KakisDayTable)
+}
+
+// saveLetterValue sets the value of char in table; a nil value removes the row.
+func saveLetterValue(tx ports.ReferenceDataTx, table, char string, value *int, changedBy int) error {
+	repo := tx.Letters(table)
+	before, err := repo.GetByChar(char)
+	if err != nil {
+		return err
+	}
+	switch {
+	case value == nil && before == nil:
+		return nil
+	case value == nil:
+		if err := repo.Delete(char); err != nil {
+			return err
+		}
+		return record(tx, table, char, domain.ChangeDelete, before, nil, changedBy)
+	case before != nil && before.Value == *value:
+		return nil
+	}
+
+	after := &domain.Numerology{Character: char, Value: *value}
+	if err := repo.Save(*after); err != nil {
+		return err
+	}
+	action := domain.ChangeUpdate
+	if before == nil {
+		action = domain.ChangeCreate
+	}
+	return record(tx, table, char, action, before, after, changedBy)
+}
+
+// saveKlakiniDays adds the letter to the bad characters of its klakini days and
+// removes it from the others.
+func saveKlakiniDays(tx ports.ReferenceDataTx, lv *domain.LetterValue, changedBy int) error {
+	r, _ := utf8.DecodeRuneInString(lv.Char)
+	repo := tx.Klakini()
+	for _, day := range numerology.Days {
+		before, err := repo.GetByDay(day)
+		if err != nil {
+			return err
+		}
+		want := lv.IsKlakiniOn(day)
+		if strings.ContainsRune(before.BadChars, r) == want {
+			continue
+		}
+
+		after := domain.Klakini{Day: day, BadChars: strings.ReplaceAll(before.BadChars, lv.Char, "")}
+		if want {
+			after.BadChars += lv.Char
+		}
+		if err := repo.Save(after); err != nil {
+			return err
+		}
+		if before.Day == "" {
+			if err := record(tx, KakisDayTable, day, domain.ChangeCreate, nil, after, changedBy); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := record(tx, KakisDayTable, day, domain.ChangeUpdate, before, after, changedBy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LetterHistory returns the latest changes of the letter tables, newest first.
+func (s *LetterDataService) LetterHistory(limit int) ([]domain.ReferenceDataChange, error) {
+	var history []domain.ReferenceDataChange
+	for _, table := range []string{SatNumsTable, ShaNumsTable, KakisDayTable} {
+		changes, err := s.store.Changes().List(table, "", limit)
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, changes...)
+	}
+	sort.SliceStable(history, func(i, j int) bool { return history[i].ChangedAt.After(history[j].ChangedAt) })
+	if len(history) > limit {
+		history = history[:limit]
+	}
+	return history, nil
+}
+
+// PreviewLetter scores a handful of sample names for day with the current letter
+// tables and with proposed, sample names containing the letter first.
+func (s *LetterDataService) PreviewLetter(proposed *domain.LetterValue, day string) (*domain.LetterPreview, error) {
+	if err := validateLetter(proposed); err != nil {
+		return nil, err
+	}
+	day = strings.ToLower(strings.TrimSpace(day))
+	if !isDay(day) {
+		return nil, fmt.Errorf("%w: unknown day %q", ErrInvalidNumberData, day)
+	}
+	samples, err := s.samples.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, withLetter := range []bool{true, false} {
+		for _, sn := range samples {
+			name := strings.TrimSpace(sn.Name)
+			if name != "" && strings.Contains(name, proposed.Char) == withLetter && len(names) < letterPreviewSamples {
+				names = append(names, name)
+			}
+		}
+	}
+
+	r, _ := utf8.DecodeRuneInString(proposed.Char)
+	after := s.engine.WithLetters(
+		func(base numerology.ValueProvider) numerology.ValueProvider {
+			return letterValues{base: base, char: proposed.Char, value: proposed.SatValue}
+		},
+		func(base numerology.ValueProvider) numerology.ValueProvider {
+			return letterValues{base: base, char: proposed.Char, value: proposed.ShaValue}
+		},
+		func(base numerology.KlakiniProvider) numerology.KlakiniProvider {
+			return letterKlakini{base: base, letter: proposed, char: r}
+		},
+	)
+
+	preview := &domain.LetterPreview{Char: proposed.Char, Day: day}
+	for _, name := range names {
+		p := domain.LetterPreviewName{
+			Name:   name,
+			Before: letterScore(s.engine.Analyze(name, day, numerology.Options{})),
+			After:  letterScore(after.Analyze(name, day, numerology.Options{})),
+		}
+		p.Changed = p.Before != p.After
+		preview.Names = append(preview.Names, p)
+	}
+	return preview, nil
+}
+
+func isDay(day string) bool {
+	for _, d := range numerology.Days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+func letterScore(a *domain.NameAnalysis) domain.LetterScore {
+	tier := domain.TierNormal
+	switch {
+	case a.HasBadPair:
+		tier = domain.TierBad
+	case a.IsTopTier:
+		tier = domain.TierTop
+	}
+	return domain.LetterScore{
+		SatTotal:   a.SatTotal,
+		ShaTotal:   a.ShaTotal,
+		TotalScore: a.TotalScore,
+		Tier:       tier,
+		Klakini:    len(a.KlakiniChars) > 0,
+	}
+}
+
+// letterValues is base with the value of one letter replaced; a nil value
+// previews removing it.
+type letterValues struct {
+	base  numerology.ValueProvider
+	char  string
+	value *int
+}
+
+func (v letterValues) GetValue(char string) (int, bool) {
+	if char != v.char {
+		return v.base.GetValue(char)
+	}
+	if v.value == nil {
+		return 0, false
+	}
+	return *v.value, true
+}
+
+// letterKlakini is base with the klakini days of one letter replaced.
+type letterKlakini struct {
+	base   numerology.KlakiniProvider
+	letter *domain.LetterValue
+	char   rune
+}
+
+func (k letterKlakini) IsKlakini(day string, r rune) bool {
+	if r != k.char {
+		return k.base.IsKlakini(day, r)
+	}
+	return k.letter.IsKlakiniOn(strings.ToLower(strings.TrimSpace(day)))
+}
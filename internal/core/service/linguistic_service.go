@@ -4,19 +4,31 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"net/url"
+	"numberniceic/internal/core/domain"
 	"strings"
 	"time"
 )
 
+// Where a linguistic analysis came from.
+const (
+	LinguisticSourceDictionary = "dictionary" // Composed from name_dictionary, LLM answers included
+	LinguisticSourceLLM        = "llm"
+	LinguisticSourceMock       = "mock"
+)
+
+var errNoLLMKey = errors.New("No valid API Keys provided")
+
 type LinguisticService struct {
 	geminiKey    string
 	anthropicKey string
 	httpClient   *http.Client
+	dictionary   *NameDictionaryService
 }
 
 // --- Gemini Request/Response Structs ---
@@ -54,7 +66,7 @@ type AnthropicResponse struct {
 	} `json:"content"`
 }
 
-func NewLinguisticService(geminiKey, anthropicKey string) (*LinguisticService, error) {
+func NewLinguisticService(geminiKey, anthropicKey string, dictionary *NameDictionaryService) (*LinguisticService, error) {
 	// Trim keys
 	cleanGeminiKey := strings.TrimSpace(geminiKey)
 	cleanAnthropicKey := strings.TrimSpace(anthropicKey)
@@ -76,26 +88,55 @@ func NewLinguisticService(geminiKey, anthropicKey string) (*LinguisticService, e
 			Transport: http.DefaultTransport,
 			Timeout:   60 * time.Second,
 		},
+		dictionary: dictionary,
 	}, nil
 }
 
-func (s *LinguisticService) AnalyzeName(name string) (string, error) {
+// AnalyzeName explains a name and reports where the explanation came from
+// (LinguisticSource*). The dictionary is tried first; the LLM is asked only for
+// what it does not know, and its answers are kept in the dictionary for review.
+func (s *LinguisticService) AnalyzeName(name string) (string, string, error) {
+	if s.dictionary == nil {
+		return s.analyzeWholeName(name)
+	}
+
+	meaning := s.dictionary.Compose(name)
+	if !meaning.Known() {
+		analysis, source, err := s.analyzeWholeName(name)
+		if err == nil && source == LinguisticSourceLLM {
+			if err := s.dictionary.Remember(domain.NameDictionaryEntry{Term: name, Kind: domain.DictionaryName, Meaning: analysis}); err != nil {
+				log.Printf("WARNING: could not keep the analysis of '%s' in the dictionary: %v", name, err)
+			}
+		}
+		return analysis, source, err
+	}
+
+	if unknown := meaning.Unknown(); len(unknown) > 0 && s.hasLLM() {
+		s.explainParts(meaning, unknown)
+	}
+	return NameMeaningMarkdown(meaning), LinguisticSourceDictionary, nil
+}
+
+func (s *LinguisticService) hasLLM() bool {
+	return s.anthropicKey != "" || s.geminiKey != ""
+}
+
+// ask sends prompt to Claude when its key is set, otherwise to Gemini.
+func (s *LinguisticService) ask(prompt string) (string, error) {
 	// 1. Prioritize Anthropic (Claude) if key exists
 	if s.anthropicKey != "" {
-		return s.analyzeNameWithClaude(name)
+		return s.askClaude(prompt)
 	}
 
 	// 2. Fallback to Gemini if key exists
 	if s.geminiKey != "" {
-		return s.analyzeNameWithGemini(name)
+		return s.askGemini(prompt)
 	}
-
-	// 3. Last Resort: Mock Data
-	log.Println("Warning: No API Keys found. Returning mock data.")
-	return s.getMockData(name, "No valid API Keys provided"), nil
+	return "", errNoLLMKey
 }
 
-func (s *LinguisticService) analyzeNameWithClaude(name string) (string, error) {
+// analyzeWholeName asks the LLM about the whole name, falling back to mock text.
+func (s *LinguisticService) analyzeWholeName(name string) (string, string, error) {
 	prompt := fmt.Sprintf(
 		"วิเคราะห์ชื่อ '%s' ตามหลักภาษาศาสตร์ไทย โดยไม่ต้องสนใจเรื่องตัวเลขหรือเลขศาสตร์ ให้เน้นที่:\n"+
 			"1. รากศัพท์ของแต่ละพยางค์ (ถ้ามี)\n"+
@@ -106,6 +147,60 @@ func (s *LinguisticService) analyzeNameWithClaude(name string) (string, error) {
 		name,
 	)
 
+	analysis, err := s.ask(prompt)
+	if err != nil {
+		// Last Resort: Mock Data
+		log.Printf("Warning: %v. Returning mock data.", err)
+		return s.getMockData(name, err.Error()), LinguisticSourceMock, nil
+	}
+	return analysis, LinguisticSourceLLM, nil
+}
+
+// explainParts asks the LLM for the parts of a name the dictionary does not
+// know, fills them into meaning and keeps the answers for review. Parts the LLM
+// cannot explain stay unknown.
+func (s *LinguisticService) explainParts(meaning *domain.NameMeaning, unknown []string) {
+	prompt := fmt.Sprintf(
+		"ให้ความหมายของส่วนต่อไปนี้ในชื่อ '%s' ตามหลักภาษาไทย ระบุรากศัพท์บาลีหรือสันสกฤตถ้ามี: %s\n"+
+			"ตอบเป็น JSON เท่านั้น ในรูปแบบ {\"ส่วนของชื่อ\": {\"origin\": \"ภาษาที่มา\", \"meaning\": \"ความหมายสั้นๆ\"}}",
+		meaning.Name, strings.Join(unknown, ", "),
+	)
+	answer, err := s.ask(prompt)
+	if err != nil {
+		log.Printf("WARNING: LLM could not explain %v of '%s': %v", unknown, meaning.Name, err)
+		return
+	}
+
+	var parts map[string]struct {
+		Origin  string `json:"origin"`
+		Meaning string `json:"meaning"`
+	}
+	start, end := strings.Index(answer, "{"), strings.LastIndex(answer, "}")
+	if start < 0 || end < start {
+		log.Printf("WARNING: LLM answer for the parts of '%s' has no JSON", meaning.Name)
+		return
+	}
+	if err := json.Unmarshal([]byte(answer[start:end+1]), &parts); err != nil {
+		log.Printf("WARNING: LLM answer for the parts of '%s' is not valid JSON: %v", meaning.Name, err)
+		return
+	}
+
+	for i := range meaning.Parts {
+		p := &meaning.Parts[i]
+		part, ok := parts[p.Text]
+		if p.Entry != nil || !ok || strings.TrimSpace(part.Meaning) == "" {
+			continue
+		}
+		entry := domain.NameDictionaryEntry{Term: p.Text, Kind: domain.DictionarySyllable, Origin: part.Origin, Meaning: part.Meaning}
+		if err := s.dictionary.Remember(entry); err != nil {
+			log.Printf("WARNING: could not keep the meaning of '%s' in the dictionary: %v", p.Text, err)
+		}
+		entry.Status = domain.DictionaryStatusPending
+		p.Entry = &entry
+	}
+}
+
+func (s *LinguisticService) askClaude(prompt string) (string, error) {
 	// List of models to try
 	models := []string{
 		"claude-sonnet-4-20250514",   // User specified (New in 2025)
@@ -171,24 +266,14 @@ func (s *LinguisticService) analyzeNameWithClaude(name string) (string, error) {
 
 		// If Auth/Credit error (401, 402, 403), stop immediately and show error
 		log.Printf("Claude API Error (Status %d): %s", resp.StatusCode, string(respBody))
-		return s.getMockData(name, fmt.Sprintf("Claude API Error (Status %d): %s", resp.StatusCode, string(respBody))), nil
+		return "", fmt.Errorf("Claude API Error (Status %d): %s", resp.StatusCode, string(respBody))
 	}
 
 	// If all models fail
-	return s.getMockData(name, "All Claude models failed (Not Found/Compatible)"), nil
+	return "", errors.New("All Claude models failed (Not Found/Compatible)")
 }
 
-func (s *LinguisticService) analyzeNameWithGemini(name string) (string, error) {
-	prompt := fmt.Sprintf(
-		"วิเคราะห์ชื่อ '%s' ตามหลักภาษาศาสตร์ไทย โดยไม่ต้องสนใจเรื่องตัวเลขหรือเลขศาสตร์ ให้เน้นที่:\n"+
-			"1. รากศัพท์ของแต่ละพยางค์ (ถ้ามี)\n"+
-			"2. ความหมายโดยรวมของชื่อ\n"+
-			"3. ความรู้สึกหรือภาพลักษณ์ที่ชื่อนี้สื่อถึง (เช่น ความอ่อนโยน, ความเข้มแข็ง, ความทันสมัย)\n"+
-			"4. ความเหมาะสมในการใช้เป็นชื่อจริง\n"+
-			"สรุปผลการวิเคราะห์ให้กระชับและเข้าใจง่ายในรูปแบบ Markdown (ใช้ bullet points)",
-		name,
-	)
-
+func (s *LinguisticService) askGemini(prompt string) (string, error) {
 	reqPayload := GeminiRequest{
 		Contents: []*Content{
 			{Parts: []*Part{{Text: prompt}}},
@@ -241,8 +326,8 @@ func (s *LinguisticService) analyzeNameWithGemini(name string) (string, error) {
 		}
 	}
 
-	// If all Gemini models fail, return Mock
-	return s.getMockData(name, "All Gemini models failed or API Key invalid"), nil
+	// If all Gemini models fail
+	return "", errors.New("All Gemini models failed or API Key invalid")
 }
 
 func (s *LinguisticService) getMockData(name, reason string) string {
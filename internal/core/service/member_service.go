@@ -29,6 +29,19 @@ func (s *MemberService) UpdateDayOfBirth(id int, dayOfWeek int) error {
 	return s.repo.UpdateDayOfBirth(id, dayOfWeek)
 }
 
+// UpdateBirthMoment parses and stores a member's birth date and optional time.
+// The derived Thai birth day is returned so callers can echo it back.
+func (s *MemberService) UpdateBirthMoment(id int, date, clock, boundary string) (*domain.BirthMoment, error) {
+	moment, err := domain.ParseBirthMoment(date, clock, boundary)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateBirthMoment(id, moment); err != nil {
+		return nil, err
+	}
+	return moment, nil
+}
+
 func (s *MemberService) HandleSocialLogin(provider, providerID, email, name, avatarURL string) (*domain.Member, error) {
 	// 1. Check if user exists by Provider + ID
 	member, err := s.repo.GetByProvider(provider, providerID)
@@ -0,0 +1,253 @@
+package service
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/numerology"
+	"numberniceic/internal/core/ports"
+	"strings"
+)
+
+// NameDictionaryService composes name meanings from the curated dictionary and
+// manages its entries. Admin writes reload the in-memory dictionary; LLM answers
+// are added to it directly.
+type NameDictionaryService struct {
+	repo     ports.NameDictionaryRepository
+	provider ports.NameDictionaryProvider
+}
+
+func NewNameDictionaryService(repo ports.NameDictionaryRepository, provider ports.NameDictionaryProvider) *NameDictionaryService {
+	return &NameDictionaryService{repo: repo, provider: provider}
+}
+
+// Compose explains name with a whole-name entry when there is one, otherwise by
+// splitting each word into the fewest known roots and syllables. Pending (LLM)
+// entries are used too, so an answer is only asked for once.
+func (s *NameDictionaryService) Compose(name string) *domain.NameMeaning {
+	name = strings.TrimSpace(name)
+	meaning := &domain.NameMeaning{Name: name}
+	if whole := s.provider.Lookup(name, domain.DictionaryName); whole != nil {
+		meaning.Whole = whole
+		return meaning
+	}
+	for _, word := range strings.Fields(name) {
+		meaning.Parts = append(meaning.Parts, s.split(word)...)
+	}
+	return meaning
+}
+
+// split covers word with dictionary parts, leaving as few clusters unknown as
+// possible and then using as few parts as possible. Neighbouring unknown
+// clusters are returned as one part.
+func (s *NameDictionaryService) split(word string) []domain.NameMeaningPart {
+	clusters := numerology.GraphemeClusters(word)
+	n, maxLen := len(clusters), s.provider.MaxPartClusters()
+
+	type step struct {
+		unknown, parts int
+		next           int
+		entry          *domain.NameDictionaryEntry
+	}
+	better := func(a, b step) bool {
+		if a.unknown != b.unknown {
+			return a.unknown < b.unknown
+		}
+		return a.parts < b.parts
+	}
+
+	// best[i] is the cheapest way to read clusters[i:].
+	best := make([]step, n+1)
+	for i := n - 1; i >= 0; i-- {
+		best[i] = step{unknown: best[i+1].unknown + 1, parts: best[i+1].parts + 1, next: i + 1}
+		for j := i + 1; j <= n && j-i <= maxLen; j++ {
+			e := s.provider.Lookup(strings.Join(clusters[i:j], ""), domain.DictionaryRoot, domain.DictionarySyllable)
+			if e == nil {
+				continue
+			}
+			if candidate := (step{unknown: best[j].unknown, parts: best[j].parts + 1, next: j, entry: e}); better(candidate, best[i]) {
+				best[i] = candidate
+			}
+		}
+	}
+
+	var parts []domain.NameMeaningPart
+	for i := 0; i < n; i = best[i].next {
+		text := strings.Join(clusters[i:best[i].next], "")
+		if e := best[i].entry; e != nil {
+			parts = append(parts, domain.NameMeaningPart{Text: text, Entry: e})
+		} else if last := len(parts) - 1; last >= 0 && parts[last].Entry == nil {
+			parts[last].Text += text
+		} else {
+			parts = append(parts, domain.NameMeaningPart{Text: text})
+		}
+	}
+	return parts
+}
+
+// Remember stores LLM answers as pending entries for review. Entries that
+// already exist, curated or not, are left alone. New entries go straight into
+// the in-memory dictionary: reloading the table once per unknown name would be
+// far too costly, and migration 030 keeps these inserts from reloading the other
+// instances too.
+func (s *NameDictionaryService) Remember(entries ...domain.NameDictionaryEntry) error {
+	var added []domain.NameDictionaryEntry
+	for i := range entries {
+		e := &entries[i]
+		e.Source, e.Status = domain.DictionarySourceLLM, domain.DictionaryStatusPending
+		if err := e.Validate(); err != nil {
+			return fmt.Errorf("dictionary entry %q: %w", e.Term, err)
+		}
+		ok, err := s.repo.CreateIfMissing(e)
+		if err != nil {
+			return err
+		}
+		if ok {
+			added = append(added, *e)
+		}
+	}
+	s.provider.Add(added...)
+	return nil
+}
+
+func (s *NameDictionaryService) Search(query, status string, limit int) ([]domain.NameDictionaryEntry, error) {
+	return s.repo.Search(query, status, limit)
+}
+
+func (s *NameDictionaryService) GetByID(id int) (*domain.NameDictionaryEntry, error) {
+	return s.repo.GetByID(id)
+}
+
+// Save creates the entry, or updates it when it has an ID. Entries saved by an
+// admin count as reviewed.
+func (s *NameDictionaryService) Save(entry *domain.NameDictionaryEntry) error {
+	entry.Status = domain.DictionaryStatusApproved
+	if err := entry.Validate(); err != nil {
+		return err
+	}
+	var err error
+	if entry.ID > 0 {
+		err = s.repo.Update(entry)
+	} else {
+		err = s.repo.Create(entry)
+	}
+	if err != nil {
+		return err
+	}
+	return s.provider.Reload()
+}
+
+// Approve marks a pending entry as reviewed without editing it.
+func (s *NameDictionaryService) Approve(id int) error {
+	entry, err := s.repo.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if entry == nil {
+		return fmt.Errorf("dictionary entry %d not found", id)
+	}
+	entry.Status = domain.DictionaryStatusApproved
+	if err := s.repo.Update(entry); err != nil {
+		return err
+	}
+	return s.provider.Reload()
+}
+
+func (s *NameDictionaryService) Delete(id int) error {
+	if err := s.repo.Delete(id); err != nil {
+		return err
+	}
+	return s.provider.Reload()
+}
+
+// ImportCSV reads term,kind,origin,meaning lines (a header line is skipped) and
+// upserts them as curated entries. Bad lines are reported and skipped.
+// A UTF-8 byte order mark, as Excel writes, is ignored.
+func (s *NameDictionaryService) ImportCSV(r io.Reader) (*domain.DictionaryImportResult, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	result := &domain.DictionaryImportResult{}
+	for line := 1; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				result.Errors = append(result.Errors, fmt.Sprintf("line %d: %v", line, parseErr.Err))
+				continue
+			}
+			return result, err
+		}
+		if line == 1 && len(record) > 0 && strings.EqualFold(strings.TrimSpace(strings.TrimPrefix(record[0], "\ufeff")), "term") {
+			continue
+		}
+		if len(record) < 4 {
+			result.Errors = append(result.Errors, fmt.Sprintf("line %d: expected term,kind,origin,meaning", line))
+			continue
+		}
+		entry := domain.NameDictionaryEntry{
+			Term:    strings.TrimPrefix(record[0], "\ufeff"),
+			Kind:    record[1],
+			Origin:  record[2],
+			Meaning: record[3],
+			Source:  domain.DictionarySourceCurated,
+			Status:  domain.DictionaryStatusApproved,
+		}
+		if err := entry.Validate(); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("line %d: %v", line, err))
+			continue
+		}
+		if err := s.repo.Upsert(&entry); err != nil {
+			return result, fmt.Errorf("line %d: %w", line, err)
+		}
+		result.Imported++
+	}
+	if result.Imported == 0 {
+		return result, nil
+	}
+	return result, s.provider.Reload()
+}
+
+// NameMeaningMarkdown writes a composed meaning in the Markdown layout of the
+// linguistic analysis.
+func NameMeaningMarkdown(m *domain.NameMeaning) string {
+	if m.Whole != nil && m.Whole.Source == domain.DictionarySourceLLM {
+		return m.Whole.Meaning // Already a full Markdown analysis
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "### ความหมายของชื่อ\n**ชื่อ:** %s\n\n", m.Name)
+	if m.Whole != nil {
+		if m.Whole.Origin != "" {
+			fmt.Fprintf(&b, "*   **รากศัพท์:** %s\n", m.Whole.Origin)
+		}
+		fmt.Fprintf(&b, "*   **ความหมาย:** %s\n", m.Whole.Meaning)
+		return b.String()
+	}
+	pending := false
+	for _, p := range m.Parts {
+		if p.Entry == nil {
+			fmt.Fprintf(&b, "*   **%s:** ไม่พบในพจนานุกรม\n", p.Text)
+			continue
+		}
+		mark := ""
+		if p.Entry.IsPending() {
+			mark, pending = " \\*", true
+		}
+		if p.Entry.Origin != "" {
+			fmt.Fprintf(&b, "*   **%s** (%s):%s %s\n", p.Text, p.Entry.Origin, mark, p.Entry.Meaning)
+		} else {
+			fmt.Fprintf(&b, "*   **%s:**%s %s\n", p.Text, mark, p.Entry.Meaning)
+		}
+	}
+	if pending {
+		b.WriteString("\n> *หมายเหตุ: ความหมายที่มีเครื่องหมาย \\* มาจาก AI และรอการตรวจสอบ*\n")
+	}
+	return b.String()
+}
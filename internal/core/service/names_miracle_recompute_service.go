@@ -0,0 +1,234 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/ports"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Defaults for a recompute run.
+const (
+	DefaultRecomputeBatchSize = 500
+	DefaultRecomputeSamples   = 50
+)
+
+// ErrRecomputeRunning is returned when a recompute is started while one is running.
+var ErrRecomputeRunning = errors.New("names_miracle recompute is already running")
+
+// RecomputeOptions tunes a recompute run.
+type RecomputeOptions struct {
+	BatchSize int
+	DryRun    bool // Report what would change without writing
+	Samples   int  // How many changed rows to keep in the report
+}
+
+// NamesMiracleRecomputeService recalculates the precomputed columns of
+// names_miracle (satnum, shanum, t_sat, t_sha, k_* and the search metadata) with the
+// current engine, after sat_nums, sha_nums, klakini or number_pairs have been edited.
+type NamesMiracleRecomputeService struct {
+	repo          ports.NamesMiracleRepository
+	numerologySvc *NumerologyService
+	onUpdated     []func()
+
+	mu     sync.Mutex
+	status domain.RecomputeStatus
+}
+
+func NewNamesMiracleRecomputeService(repo ports.NamesMiracleRepository, numerologySvc *NumerologyService) *NamesMiracleRecomputeService {
+	return &NamesMiracleRecomputeService{repo: repo, numerologySvc: numerologySvc}
+}
+
+// OnUpdated registers fn to run after a background run that updated rows, so
+// other instances and cached analyses can drop the old values.
+func (s *NamesMiracleRecomputeService) OnUpdated(fn func()) {
+	s.onUpdated = append(s.onUpdated, fn)
+}
+
+// Start runs a recompute in the background. Follow it with Status.
+func (s *NamesMiracleRecomputeService) Start(opts RecomputeOptions) error {
+	s.mu.Lock()
+	if s.status.Running {
+		s.mu.Unlock()
+		return ErrRecomputeRunning
+	}
+	s.status.Running = true
+	s.status.Progress = domain.RecomputeProgress{}
+	s.mu.Unlock()
+
+	go func() {
+		report, err := s.Run(opts, func(p domain.RecomputeProgress) {
+			s.mu.Lock()
+			s.status.Progress = p
+			s.mu.Unlock()
+		})
+		if err != nil {
+			log.Printf("names_miracle recompute failed: %v", err)
+		}
+		if report.Updated > 0 {
+			for _, fn := range s.onUpdated {
+				fn()
+			}
+		}
+		s.mu.Lock()
+		s.status.Running = false
+		s.status.Last = report
+		s.mu.Unlock()
+	}()
+	return nil
+}
+
+// Status returns the progress of the running job and the report of the last one.
+func (s *NamesMiracleRecomputeService) Status() domain.RecomputeStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.status
+}
+
+// Run walks names_miracle in name_id order, recomputes every row and updates the
+// rows that changed. onProgress, when set, is called after every batch. The
+// report is returned even when the run stops on an error.
+func (s *NamesMiracleRecomputeService) Run(opts RecomputeOptions, onProgress func(domain.RecomputeProgress)) (*domain.RecomputeReport, error) {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultRecomputeBatchSize
+	}
+	if opts.Samples < 0 {
+		opts.Samples = 0
+	}
+	report := &domain.RecomputeReport{
+		DryRun:       opts.DryRun,
+		StartedAt:    time.Now(),
+		ColumnCounts: make(map[string]int),
+	}
+	fail := func(err error) (*domain.RecomputeReport, error) {
+		report.FinishedAt = time.Now()
+		report.Error = err.Error()
+		return report, err
+	}
+
+	total, err := s.repo.Count()
+	if err != nil {
+		return fail(err)
+	}
+
+	afterID := 0
+	for {
+		rows, err := s.repo.GetBatch(afterID, opts.BatchSize)
+		if err != nil {
+			return fail(err)
+		}
+		if len(rows) == 0 {
+			break
+		}
+
+		for i := range rows {
+			stored := &rows[i]
+			afterID = stored.NameID
+			report.Scanned++
+
+			fresh, err := s.numerologySvc.CalculateNameDetails(stored.ThName)
+			if err != nil {
+				report.Invalid = append(report.Invalid, domain.NamesMiracleDiff{
+					NameID:  stored.NameID,
+					Name:    stored.ThName,
+					Changes: []domain.ColumnChange{{Column: "thname", Before: stored.ThName, After: err.Error()}},
+				})
+				continue
+			}
+
+			changes := diffNamesMiracle(stored, fresh)
+			if len(changes) == 0 {
+				continue
+			}
+			report.Changed++
+			for _, c := range changes {
+				report.ColumnCounts[c.Column]++
+			}
+			if len(report.Samples) < opts.Samples {
+				report.Samples = append(report.Samples, domain.NamesMiracleDiff{NameID: stored.NameID, Name: stored.ThName, Changes: changes})
+			}
+
+			if opts.DryRun {
+				continue
+			}
+			fresh.NameID = stored.NameID
+			if err := s.repo.UpdateComputed(fresh); err != nil {
+				return fail(fmt.Errorf("update name_id %d: %w", stored.NameID, err))
+			}
+			report.Updated++
+		}
+
+		if onProgress != nil {
+			onProgress(domain.RecomputeProgress{Scanned: report.Scanned, Total: total, Changed: report.Changed})
+		}
+	}
+
+	report.FinishedAt = time.Now()
+	return report, nil
+}
+
+// diffNamesMiracle compares the stored columns of a row with freshly computed ones.
+func diffNamesMiracle(stored, fresh *domain.SimilarNameResult) []domain.ColumnChange {
+	var changes []domain.ColumnChange
+	add := func(column, before, after string) {
+		if before != after {
+			changes = append(changes, domain.ColumnChange{Column: column, Before: before, After: after})
+		}
+	}
+
+	add("satnum", strings.Join(stored.SatNum, ","), strings.Join(fresh.SatNum, ","))
+	add("shanum", strings.Join(stored.ShaNum, ","), strings.Join(fresh.ShaNum, ","))
+	add("t_sat", joinPairTypes(stored.TSat), joinPairTypes(fresh.TSat))
+	add("t_sha", joinPairTypes(stored.TSha), joinPairTypes(fresh.TSha))
+	add("phonetic_key", stored.PhoneticKey, fresh.PhoneticKey)
+	add("gender", stored.Gender, fresh.Gender)
+	add("syllables", strconv.Itoa(stored.Syllables), strconv.Itoa(fresh.Syllables))
+	add("first_letter", stored.FirstLetter, fresh.FirstLetter)
+	add("category_strengths", formatStrengths(stored.CategoryStrengths), formatStrengths(fresh.CategoryStrengths))
+
+	klakini := []struct {
+		column        string
+		before, after bool
+	}{
+		{"k_sunday", stored.KSunday, fresh.KSunday},
+		{"k_monday", stored.KMonday, fresh.KMonday},
+		{"k_tuesday", stored.KTuesday, fresh.KTuesday},
+		{"k_wednesday1", stored.KWednesday1, fresh.KWednesday1},
+		{"k_wednesday2", stored.KWednesday2, fresh.KWednesday2},
+		{"k_thursday", stored.KThursday, fresh.KThursday},
+		{"k_friday", stored.KFriday, fresh.KFriday},
+		{"k_saturday", stored.KSaturday, fresh.KSaturday},
+	}
+	for _, k := range klakini {
+		add(k.column, fmt.Sprint(k.before), fmt.Sprint(k.after))
+	}
+	return changes
+}
+
+// formatStrengths writes category strengths in key order, e.g. "การงาน:2,โชคลาภ:-1".
+func formatStrengths(strengths map[string]int) string {
+	categories := make([]string, 0, len(strengths))
+	for category := range strengths {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	parts := make([]string, len(categories))
+	for i, category := range categories {
+		parts[i] = fmt.Sprintf("%s:%d", category, strengths[category])
+	}
+	return strings.Join(parts, ",")
+}
+
+func joinPairTypes(types []domain.PairTypeInfo) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.Type
+	}
+	return strings.Join(names, ",")
+}
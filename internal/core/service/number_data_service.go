@@ -0,0 +1,340 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/numerology"
+	"numberniceic/internal/core/ports"
+	"strconv"
+	"strings"
+)
+
+// Tables whose edits are recorded by NumberDataService.
+const (
+	NumbersTable          = "numbers"
+	NumberCategoriesTable = "number_categories"
+)
+
+// Errors the number data handlers map to 400 and 404.
+var (
+	ErrInvalidNumberData  = errors.New("invalid number data")
+	ErrNumberDataNotFound = errors.New("not found")
+)
+
+// impactBatchSize is how many names_miracle rows a preview reads at a time.
+const impactBatchSize = 2000
+
+// NumberDataService edits the pair meanings (numbers) and their categories,
+// records every edit and previews how an edit changes the tier of the names in
+// names_miracle and of the phone numbers for sale.
+type NumberDataService struct {
+	store      ports.ReferenceDataStore
+	names      ports.NamesMiracleRepository
+	phones     ports.PhoneNumberRepository
+	pairs      ports.NumberPairProvider
+	categories ports.NumberCategoryProvider
+	rulesets   ports.ScoringRulesetProvider
+}
+
+func NewNumberDataService(store ports.ReferenceDataStore, names ports.NamesMiracleRepository, phones ports.PhoneNumberRepository, pairs ports.NumberPairProvider, categories ports.NumberCategoryProvider, rulesets ports.ScoringRulesetProvider) *NumberDataService {
+	return &NumberDataService{
+		store:      store,
+		names:      names,
+		phones:     phones,
+		pairs:      pairs,
+		categories: categories,
+		rulesets:   rulesets,
+	}
+}
+
+func (s *NumberDataService) ruleset() *domain.ScoringRuleset {
+	if s.rulesets != nil {
+		if rs := s.rulesets.Active(); rs != nil {
+			return rs
+		}
+	}
+	return numerology.DefaultRuleset()
+}
+
+// ListPairs returns every pair with its tier colour in the active ruleset.
+func (s *NumberDataService) ListPairs() ([]domain.NumberPairMeaning, error) {
+	pairs, err := s.store.Pairs().List()
+	if err != nil {
+		return nil, err
+	}
+	rs := s.ruleset()
+	for i := range pairs {
+		pairs[i].Color = rs.TierColor(pairs[i].PairType)
+		pairs[i].IsBad = rs.IsBad(pairs[i].PairType)
+	}
+	return pairs, nil
+}
+
+func (s *NumberDataService) GetPair(pairNumber string) (*domain.NumberPairMeaning, error) {
+	return s.store.Pairs().GetByPair(strings.TrimSpace(pairNumber))
+}
+
+// SavePair creates or updates the pair and records the change.
+func (s *NumberDataService) SavePair(m *domain.NumberPairMeaning, changedBy int) error {
+	if err := m.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidNumberData, err)
+	}
+	m.Color, m.Category, m.Keywords, m.IsBad = "", "", nil, false
+	return s.edit(func(tx ports.ReferenceDataTx) error {
+		before, err := tx.Pairs().GetByPair(m.PairNumber)
+		if err != nil {
+			return err
+		}
+		if err := tx.Pairs().Save(m); err != nil {
+			return err
+		}
+		action := domain.ChangeUpdate
+		if before == nil {
+			action = domain.ChangeCreate
+		}
+		return record(tx, NumbersTable, m.PairNumber, action, before, m, changedBy)
+	})
+}
+
+func (s *NumberDataService) DeletePair(pairNumber string, changedBy int) error {
+	return s.edit(func(tx ports.ReferenceDataTx) error {
+		before, err := tx.Pairs().GetByPair(strings.TrimSpace(pairNumber))
+		if err != nil {
+			return err
+		}
+		if before == nil {
+			return fmt.Errorf("pair %s: %w", pairNumber, ErrNumberDataNotFound)
+		}
+		if err := tx.Pairs().Delete(before.PairNumber); err != nil {
+			return err
+		}
+		return record(tx, NumbersTable, before.PairNumber, domain.ChangeDelete, before, nil, changedBy)
+	})
+}
+
+func (s *NumberDataService) ListCategories() ([]domain.NumberCategory, error) {
+	return s.store.Categories().GetAll()
+}
+
+func (s *NumberDataService) GetCategory(id int) (*domain.NumberCategory, error) {
+	return s.store.Categories().GetByID(id)
+}
+
+// SaveCategory creates the category, or updates it when it has an ID, and records the change.
+func (s *NumberDataService) SaveCategory(c *domain.NumberCategory, changedBy int) error {
+	if err := c.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidNumberData, err)
+	}
+	if !isNumberCategory(c.Category) {
+		return fmt.Errorf("%w: category must be one of %s", ErrInvalidNumberData, strings.Join(numerology.Categories, ", "))
+	}
+
+	return s.edit(func(tx ports.ReferenceDataTx) error {
+		if c.ID == 0 {
+			if err := tx.Categories().Create(c); err != nil {
+				return err
+			}
+			return record(tx, NumberCategoriesTable, strconv.Itoa(c.ID), domain.ChangeCreate, nil, c, changedBy)
+		}
+		before, err := tx.Categories().GetByID(c.ID)
+		if err != nil {
+			return err
+		}
+		if before == nil {
+			return fmt.Errorf("category %d: %w", c.ID, ErrNumberDataNotFound)
+		}
+		if err := tx.Categories().Update(c); err != nil {
+			return err
+		}
+		return record(tx, NumberCategoriesTable, strconv.Itoa(c.ID), domain.ChangeUpdate, before, c, changedBy)
+	})
+}
+
+func (s *NumberDataService) DeleteCategory(id, changedBy int) error {
+	return s.edit(func(tx ports.ReferenceDataTx) error {
+		before, err := tx.Categories().GetByID(id)
+		if err != nil {
+			return err
+		}
+		if before == nil {
+			return fmt.Errorf("category %d: %w", id, ErrNumberDataNotFound)
+		}
+		if err := tx.Categories().Delete(id); err != nil {
+			return err
+		}
+		return record(tx, NumberCategoriesTable, strconv.Itoa(id), domain.ChangeDelete, before, nil, changedBy)
+	})
+}
+
+// History returns the latest changes of table, optionally of one record.
+func (s *NumberDataService) History(table, recordKey string, limit int) ([]domain.ReferenceDataChange, error) {
+	if table != NumbersTable && table != NumberCategoriesTable {
+		return nil, fmt.Errorf("%w: unknown table %q", ErrInvalidNumberData, table)
+	}
+	return s.store.Changes().List(table, recordKey, limit)
+}
+
+func isNumberCategory(category string) bool {
+	for _, c := range numerology.Categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// edit runs fn in one transaction, so an edit is never saved without its
+// record, then reloads the caches built from both tables; the pair cache joins
+// the categories in.
+func (s *NumberDataService) edit(fn func(tx ports.ReferenceDataTx) error) error {
+	if err := s.store.InTx(fn); err != nil {
+		return err
+	}
+	return errors.Join(s.pairs.Reload(), s.categories.Reload())
+}
+
+// record stores the change in the transaction of the edit.
+func record(tx ports.ReferenceDataTx, table, key, action string, before, after interface{}, changedBy int) error {
+	change := &domain.ReferenceDataChange{TableName: table, RecordKey: key, Action: action, ChangedBy: changedBy}
+	var err error
+	if change.Before, err = marshalChange(before); err != nil {
+		return err
+	}
+	if change.After, err = marshalChange(after); err != nil {
+		return err
+	}
+	if err := tx.Changes().Record(change); err != nil {
+		return fmt.Errorf("recording the change: %w", err)
+	}
+	return nil
+}
+
+// marshalChange stores a missing record (a nil pointer) as no document.
+func marshalChange(v interface{}) (json.RawMessage, error) {
+	b, err := json.Marshal(v)
+	if err != nil || string(b) == "null" {
+		return nil, err
+	}
+	return b, nil
+}
+
+// PreviewPair reports how saving proposed would change the tiers; a nil
+// proposed previews deleting the pair.
+func (s *NumberDataService) PreviewPair(pairNumber string, proposed *domain.NumberPairMeaning) (*domain.NumberDataImpact, error) {
+	pairNumber = strings.TrimSpace(pairNumber)
+	if proposed != nil {
+		if err := proposed.Validate(); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidNumberData, err)
+		}
+		pairNumber = proposed.PairNumber
+	}
+	after := func(p string) (domain.NumberPairMeaning, bool) {
+		if p != pairNumber {
+			return s.pairs.GetMeaning(p)
+		}
+		if proposed == nil {
+			return domain.NumberPairMeaning{}, false
+		}
+		return *proposed, true
+	}
+	return s.impact(pairNumber, after)
+}
+
+// PreviewCategory lists the names and phone numbers containing the pair whose
+// category breakdown an edit changes. Categories do not decide tiers, so no tier changes.
+func (s *NumberDataService) PreviewCategory(pairNumber string) (*domain.NumberDataImpact, error) {
+	pairNumber = strings.TrimSpace(pairNumber)
+	if !domain.IsPairNumber(pairNumber) {
+		return nil, fmt.Errorf("%w: pair_number must be two digits", ErrInvalidNumberData)
+	}
+	return s.impact(pairNumber, s.pairs.GetMeaning)
+}
+
+func (s *NumberDataService) impact(pairNumber string, after func(string) (domain.NumberPairMeaning, bool)) (*domain.NumberDataImpact, error) {
+	rs := s.ruleset()
+	before := s.pairs.GetMeaning
+	result := &domain.NumberDataImpact{PairNumber: pairNumber}
+
+	for afterID := 0; ; {
+		batch, err := s.names.GetBatch(afterID, impactBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(batch) == 0 {
+			break
+		}
+		for _, n := range batch {
+			afterID = n.NameID
+			if !containsPair(n.SatNum, pairNumber) && !containsPair(n.ShaNum, pairNumber) {
+				continue
+			}
+			result.Names.Add(n.ThName, nameTier(rs, before, n.SatNum, n.ShaNum), nameTier(rs, after, n.SatNum, n.ShaNum))
+		}
+	}
+
+	phones, err := s.phones.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range phones {
+		if !p.IsSellable() {
+			continue
+		}
+		pairs := phonePairs(p)
+		if !containsPair(pairs, pairNumber) {
+			continue
+		}
+		result.Phones.Add(p.PNumberNum, pairsTier(rs, before, pairs), pairsTier(rs, after, pairs))
+	}
+	return result, nil
+}
+
+func containsPair(pairs []string, pair string) bool {
+	for _, p := range pairs {
+		if p == pair {
+			return true
+		}
+	}
+	return false
+}
+
+// phonePairs are the consecutive digit pairs of the number and its sum.
+func phonePairs(p domain.PhoneNumberSell) []string {
+	digits := strings.TrimSpace(strings.ReplaceAll(p.PNumberNum, "-", ""))
+	var pairs []string
+	for i := 0; i+1 < len(digits); i++ {
+		pairs = append(pairs, digits[i:i+2])
+	}
+	return append(pairs, strings.TrimSpace(p.PNumberSum))
+}
+
+// nameTier follows the engine: top tier needs both pillars fully top-tier.
+func nameTier(rs *domain.ScoringRuleset, lookup func(string) (domain.NumberPairMeaning, bool), sat, sha []string) string {
+	satTier, shaTier := pairsTier(rs, lookup, sat), pairsTier(rs, lookup, sha)
+	switch {
+	case satTier == domain.TierBad || shaTier == domain.TierBad:
+		return domain.TierBad
+	case satTier == domain.TierTop && shaTier == domain.TierTop:
+		return domain.TierTop
+	}
+	return domain.TierNormal
+}
+
+func pairsTier(rs *domain.ScoringRuleset, lookup func(string) (domain.NumberPairMeaning, bool), pairs []string) string {
+	top := len(pairs) > 0
+	for _, p := range pairs {
+		m, ok := lookup(p)
+		if ok && rs.IsBad(m.PairType) {
+			return domain.TierBad
+		}
+		if !ok || !rs.IsTopTierType(m.PairType) {
+			top = false
+		}
+	}
+	if top {
+		return domain.TierTop
+	}
+	return domain.TierNormal
+}
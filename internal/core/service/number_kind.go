@@ -0,0 +1,237 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"numberniceic/internal/core/domain"
+	"strings"
+)
+
+// Kinds of number the number analyzer reads.
+const (
+	NumberKindPhone = "phone"
+	NumberKindHouse = "house"
+	NumberKindBank  = "bank"
+	NumberKindID    = "id"
+)
+
+// NumberKindProfile defines how one kind of number is read: which characters are
+// kept, which pairs are main and which hidden, what the sum is taken over and
+// how the pair points add up to the total percent.
+type NumberKindProfile struct {
+	Kind  string
+	Label string
+	// Normalize returns the number in the form it is read, or an error when raw is not a number of this kind.
+	Normalize func(raw string) (string, error)
+	// Pairs splits the normalised number into main and hidden pairs.
+	Pairs func(number string) (main, hidden []string)
+	// Sum is the total the sum meaning is read from.
+	Sum func(number string) int
+	// Total weighs the points of the pairs and the sum into the total percent.
+	Total func(main, hidden []domain.PhoneNumberPairMeaning, sum domain.PhoneNumberPairMeaning) float64
+}
+
+var numberKindProfiles = map[string]NumberKindProfile{
+	NumberKindPhone: {
+		Kind:      NumberKindPhone,
+		Label:     "เบอร์โทรศัพท์",
+		Normalize: normalizePhoneNumber,
+		Pairs:     alternatingPairs,
+		Sum:       digitSum,
+		Total:     phoneTotal,
+	},
+	NumberKindHouse: {
+		Kind:      NumberKindHouse,
+		Label:     "บ้านเลขที่",
+		Normalize: normalizeHouseNumber,
+		Pairs:     housePairs,
+		Sum:       digitSum,
+		Total:     spreadTotal,
+	},
+	NumberKindBank: {
+		Kind:      NumberKindBank,
+		Label:     "เลขบัญชีธนาคาร",
+		Normalize: digitsOfLength(10, 15, "เลขบัญชีธนาคารต้องมี 10-15 หลัก"),
+		Pairs:     alternatingPairs,
+		Sum:       digitSum,
+		Total:     spreadTotal,
+	},
+	NumberKindID: {
+		Kind:      NumberKindID,
+		Label:     "เลขบัตรประชาชน",
+		Normalize: normalizeIDNumber,
+		Pairs:     alternatingPairs,
+		Sum:       digitSum,
+		Total:     spreadTotal,
+	},
+}
+
+// NumberKind returns the profile for kind; an empty kind is a phone number.
+func NumberKind(kind string) (NumberKindProfile, error) {
+	if kind == "" {
+		kind = NumberKindPhone
+	}
+	profile, ok := numberKindProfiles[strings.ToLower(strings.TrimSpace(kind))]
+	if !ok {
+		return NumberKindProfile{}, fmt.Errorf("unknown number kind %q", kind)
+	}
+	return profile, nil
+}
+
+// stripSeparators drops the spaces and dashes people type between digit groups.
+func stripSeparators(raw string) string {
+	return strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(raw))
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func digitsOfLength(min, max int, message string) func(string) (string, error) {
+	return func(raw string) (string, error) {
+		number := stripSeparators(raw)
+		if !isDigits(number) || len(number) < min || len(number) > max {
+			return "", errors.New(message)
+		}
+		return number, nil
+	}
+}
+
+// normalizePhoneNumber also turns an international +66 prefix into the leading 0.
+func normalizePhoneNumber(raw string) (string, error) {
+	number := stripSeparators(raw)
+	if strings.HasPrefix(number, "+66") {
+		number = "0" + strings.TrimPrefix(number, "+66")
+	}
+	if !isDigits(number) || len(number) < 9 || len(number) > 10 {
+		return "", errors.New("เบอร์โทรศัพท์ต้องมี 9-10 หลัก")
+	}
+	return number, nil
+}
+
+// normalizeHouseNumber keeps the slash between the house number and its sub-number, e.g. 99/123.
+func normalizeHouseNumber(raw string) (string, error) {
+	number := stripSeparators(raw)
+	parts := strings.Split(number, "/")
+	if len(parts) > 2 {
+		return "", errors.New("บ้านเลขที่มีเครื่องหมาย / ได้ไม่เกินหนึ่งตัว")
+	}
+	for _, p := range parts {
+		if !isDigits(p) {
+			return "", errors.New("บ้านเลขที่ต้องเป็นตัวเลข เช่น 99 หรือ 99/123")
+		}
+	}
+	return number, nil
+}
+
+// normalizeIDNumber accepts a 13 digit Thai national ID with a valid check digit.
+func normalizeIDNumber(raw string) (string, error) {
+	number := stripSeparators(raw)
+	if !isDigits(number) || len(number) != 13 {
+		return "", errors.New("เลขบัตรประชาชนต้องมี 13 หลัก")
+	}
+	sum := 0
+	for i := 0; i < 12; i++ {
+		sum += int(number[i]-'0') * (13 - i)
+	}
+	if (11-sum%11)%10 != int(number[12]-'0') {
+		return "", errors.New("เลขบัตรประชาชนไม่ถูกต้อง")
+	}
+	return number, nil
+}
+
+// alternatingPairs reads main pairs from even positions (01, 23, 45...) and
+// hidden pairs from odd positions (12, 34, 56...).
+func alternatingPairs(number string) (main, hidden []string) {
+	for i := 0; i+1 < len(number); i++ {
+		if i%2 == 0 {
+			main = append(main, number[i:i+2])
+		} else {
+			hidden = append(hidden, number[i:i+2])
+		}
+	}
+	return main, hidden
+}
+
+// housePairs reads every neighbouring pair of the house number and of the
+// sub-number as main pairs; the pair that spans the slash is hidden.
+func housePairs(number string) (main, hidden []string) {
+	parts := strings.Split(number, "/")
+	for _, p := range parts {
+		for i := 0; i+1 < len(p); i++ {
+			main = append(main, p[i:i+2])
+		}
+	}
+	if len(parts) == 2 {
+		hidden = append(hidden, parts[0][len(parts[0])-1:]+parts[1][:1])
+	}
+	return main, hidden
+}
+
+func digitSum(number string) int {
+	sum := 0
+	for _, r := range number {
+		if r >= '0' && r <= '9' {
+			sum += int(r - '0')
+		}
+	}
+	return sum
+}
+
+// Shares of the total percent: main pairs 55%, hidden pairs 25% and the sum 20%.
+const (
+	mainPairsShare   = 0.55
+	hiddenPairsShare = 0.25
+	sumShare         = 0.20
+)
+
+// phoneTotal weighs the pairs of a 10 digit phone number by position, the later
+// pairs counting most: main pairs 5, 5, 10, 15 and 20%, hidden pairs 3, 5, 5 and
+// 12%, and the sum 20%.
+func phoneTotal(main, hidden []domain.PhoneNumberPairMeaning, sum domain.PhoneNumberPairMeaning) float64 {
+	total := 0.0
+	mainWeights := []float64{0.05, 0.05, 0.10, 0.15, 0.20}
+	for i, pair := range main {
+		if i < len(mainWeights) {
+			total += float64(pair.Meaning.PairPoint) * mainWeights[i]
+		}
+	}
+	hiddenWeights := []float64{0.03, 0.05, 0.05, 0.12}
+	for i, pair := range hidden {
+		if i < len(hiddenWeights) {
+			total += float64(pair.Meaning.PairPoint) * hiddenWeights[i]
+		}
+	}
+	return total + float64(sum.Meaning.PairPoint)*sumShare
+}
+
+// spreadTotal splits each share evenly over the pairs a number has, so numbers
+// of any length can reach the full percent. A share with no pairs, such as the
+// hidden pairs of a house number without a sub-number, goes to the main pairs,
+// and to the sum when there are no pairs at all.
+func spreadTotal(main, hidden []domain.PhoneNumberPairMeaning, sum domain.PhoneNumberPairMeaning) float64 {
+	mainShare, hiddenShare, sumWeight := mainPairsShare, hiddenPairsShare, sumShare
+	if len(hidden) == 0 {
+		mainShare, hiddenShare = mainShare+hiddenShare, 0
+	}
+	if len(main) == 0 {
+		mainShare, sumWeight = 0, sumWeight+mainShare
+	}
+	return mainShare*averagePoint(main) + hiddenShare*averagePoint(hidden) + sumWeight*float64(sum.Meaning.PairPoint)
+}
+
+func averagePoint(pairs []domain.PhoneNumberPairMeaning) float64 {
+	if len(pairs) == 0 {
+		return 0
+	}
+	total := 0
+	for _, p := range pairs {
+		total += p.Meaning.PairPoint
+	}
+	return float64(total) / float64(len(pairs))
+}
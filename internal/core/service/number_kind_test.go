@@ -0,0 +1,51 @@
+package service
+
+import (
+	"numberniceic/internal/core/domain"
+	"testing"
+)
+
+func pointPairs(points ...int) []domain.PhoneNumberPairMeaning {
+	pairs := make([]domain.PhoneNumberPairMeaning, len(points))
+	for i, p := range points {
+		pairs[i].Meaning.PairPoint = p
+	}
+	return pairs
+}
+
+func TestShortHouseNumberReachesFullPercent(t *testing.T) {
+	house, err := NumberKind(NumberKindHouse)
+	if err != nil {
+		t.Fatal(err)
+	}
+	number, err := house.Normalize("99")
+	if err != nil {
+		t.Fatal(err)
+	}
+	main, hidden := house.Pairs(number)
+	if len(main) != 1 || len(hidden) != 0 {
+		t.Fatalf("Pairs(%q) = %v, %v, want one main pair", number, main, hidden)
+	}
+
+	// Every pair and the sum at full points
+	sum := pointPairs(100)[0]
+	if got := house.Total(pointPairs(100), nil, sum); got != 100 {
+		t.Errorf("house Total = %v, want 100", got)
+	}
+
+	phone, _ := NumberKind(NumberKindPhone)
+	if got := phone.Total(pointPairs(100), nil, sum); got > 25 {
+		t.Errorf("phone Total of one pair = %v, want the phone weights (at most 25)", got)
+	}
+}
+
+func TestSpreadTotalShares(t *testing.T) {
+	// 55% over the main pairs, 25% over the hidden pairs, 20% for the sum
+	got := spreadTotal(pointPairs(100, 0), pointPairs(100), pointPairs(0)[0])
+	if want := 0.55*50 + 0.25*100; got != want {
+		t.Errorf("spreadTotal = %v, want %v", got, want)
+	}
+	if got := spreadTotal(nil, nil, pointPairs(80)[0]); got != 80 {
+		t.Errorf("spreadTotal without pairs = %v, want the sum's points", got)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"log"
 	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/numerology"
 	"numberniceic/internal/core/ports"
 	"sort"
 	"strconv"
@@ -13,8 +14,8 @@ import (
 
 type PhoneNumberService struct {
 	repo        ports.PhoneNumberRepository
-	pairRepo    ports.NumberPairRepository
-	pairCache   map[string]domain.NumberPairMeaning
+	pairs       ports.NumberPairProvider // Shared with the engine, reloaded by the cache registry
+	rulesets    ports.ScoringRulesetProvider
 	aspectCache map[string]map[string]AspectData // pair -> category -> data
 }
 
@@ -37,25 +38,30 @@ type AspectDetail struct {
 	Insight    string `json:"insight"`
 }
 
-func NewPhoneNumberService(repo ports.PhoneNumberRepository, pairRepo ports.NumberPairRepository) *PhoneNumberService {
+func NewPhoneNumberService(repo ports.PhoneNumberRepository, pairs ports.NumberPairProvider, rulesets ports.ScoringRulesetProvider) *PhoneNumberService {
 	s := &PhoneNumberService{
 		repo:        repo,
-		pairRepo:    pairRepo,
-		pairCache:   make(map[string]domain.NumberPairMeaning),
+		pairs:       pairs,
+		rulesets:    rulesets,
 		aspectCache: make(map[string]map[string]AspectData),
 	}
-	s.ReloadCache()
 	s.LoadAspectsFromJSON("numbers.json") // Load from root
 	return s
 }
 
-func (s *PhoneNumberService) ReloadCache() {
-	pairs, err := s.pairRepo.GetAll()
-	if err == nil {
-		for _, p := range pairs {
-			s.pairCache[p.PairNumber] = p
+// ruleset is the active scoring ruleset, or the built-in one before any is loaded.
+func (s *PhoneNumberService) ruleset() *domain.ScoringRuleset {
+	if s.rulesets != nil {
+		if rs := s.rulesets.Active(); rs != nil {
+			return rs
 		}
 	}
+	return numerology.DefaultRuleset()
+}
+
+// pairColor is the tier colour of pairType in the active scoring ruleset.
+func (s *PhoneNumberService) pairColor(pairType string) string {
+	return s.ruleset().TierColor(pairType)
 }
 
 func (s *PhoneNumberService) LoadAspectsFromJSON(filePath string) {
@@ -134,7 +140,7 @@ func (s *PhoneNumberService) AnalyzeNumber(num domain.PhoneNumberSell) domain.Ph
 	if len(cleaned) >= 2 {
 		for i := 0; i < len(cleaned)-1; i++ {
 			p := cleaned[i : i+2]
-			meaning, ok := s.pairCache[p]
+			meaning, ok := s.pairs.GetMeaning(p)
 			if !ok {
 				meaning = domain.NumberPairMeaning{
 					PairNumber: p,
@@ -142,7 +148,7 @@ func (s *PhoneNumberService) AnalyzeNumber(num domain.PhoneNumberSell) domain.Ph
 				}
 			}
 			if meaning.Color == "" {
-				meaning.Color = GetPairTypeColor(meaning.PairType)
+				meaning.Color = s.pairColor(meaning.PairType)
 			}
 
 			pairMeaning := domain.PhoneNumberPairMeaning{
@@ -159,7 +165,7 @@ func (s *PhoneNumberService) AnalyzeNumber(num domain.PhoneNumberSell) domain.Ph
 		}
 	}
 
-	sumMeaning, ok := s.pairCache[num.PNumberSum]
+	sumMeaning, ok := s.pairs.GetMeaning(num.PNumberSum)
 	if !ok {
 		sumMeaning = domain.NumberPairMeaning{
 			PairNumber: num.PNumberSum,
@@ -167,7 +173,7 @@ func (s *PhoneNumberService) AnalyzeNumber(num domain.PhoneNumberSell) domain.Ph
 		}
 	}
 	if sumMeaning.Color == "" {
-		sumMeaning.Color = GetPairTypeColor(sumMeaning.PairType)
+		sumMeaning.Color = s.pairColor(sumMeaning.PairType)
 	}
 
 	return domain.PhoneNumberAnalysis{
@@ -222,15 +228,16 @@ func (s *PhoneNumberService) CalculateWeightedCategoryScore(numberStr string, ca
 	sumWeight := 0.20
 
 	totalScore := 0.0
+	rs := s.ruleset()
 
 	getPercent := func(pair string) float64 {
-		// Check if pair is Bad (R-type)
-		if meaning, ok := s.pairCache[pair]; ok {
-			if strings.HasPrefix(meaning.PairType, "R") {
+		// Check if pair is Bad in the active ruleset
+		if meaning, ok := s.pairs.GetMeaning(pair); ok {
+			if rs.IsBad(meaning.PairType) {
 				return -50.0 // Heavy penalty for bad pairs in a lucky number
 			}
-			// Only count positive score if it's a D-type or Neutral with good aspect
-			if !strings.HasPrefix(meaning.PairType, "D") && meaning.PairPoint < 0 {
+			// Only count positive score if it's a good type or Neutral with good aspect
+			if !rs.IsGood(meaning.PairType) && meaning.PairPoint < 0 {
 				return 0.0 // Don't allow negative impact pairs to contribute positive score
 			}
 		}
@@ -282,25 +289,26 @@ func (s *PhoneNumberService) GetLuckyNumberByCategory(category string, index int
 	}
 
 	var matchingNumbers []scoredNumber
+	rs := s.ruleset()
 
 	// Calculate weighted score for ALL numbers for this specific category
 	for _, num := range numbers {
-		// 1. HARD FILTER: A "Lucky Number" must NOT contain any R-type (Bad) pairs
+		// 1. HARD FILTER: A "Lucky Number" must NOT contain any bad pairs
 		// This prevents %ร้าย from appearing in the chart.
 		cleaned := strings.ReplaceAll(num.PNumberNum, "-", "")
 		hasBadPair := false
 		for i := 0; i < len(cleaned)-1; i++ {
 			p := cleaned[i : i+2]
-			if meaning, ok := s.pairCache[p]; ok {
-				if strings.HasPrefix(meaning.PairType, "R") {
+			if meaning, ok := s.pairs.GetMeaning(p); ok {
+				if rs.IsBad(meaning.PairType) {
 					hasBadPair = true
 					break
 				}
 			}
 		}
 		// Also check sum
-		if meaning, ok := s.pairCache[num.PNumberSum]; ok {
-			if strings.HasPrefix(meaning.PairType, "R") {
+		if meaning, ok := s.pairs.GetMeaning(num.PNumberSum); ok {
+			if rs.IsBad(meaning.PairType) {
 				hasBadPair = true
 			}
 		}
@@ -345,7 +353,7 @@ func (s *PhoneNumberService) GetLuckyNumberByCategory(category string, index int
 
 		// Fallback to generic keywords if no insight found
 		if len(finalKeywords) == 0 {
-			if meaning, ok := s.pairCache[sumKey]; ok {
+			if meaning, ok := s.pairs.GetMeaning(sumKey); ok {
 				if len(meaning.Keywords) > 0 {
 					finalKeywords = meaning.Keywords
 				}
@@ -395,66 +403,50 @@ func (s *PhoneNumberService) ExtractPairs(num string) []string {
 func (s *PhoneNumberService) AnalyzeRawNumber(number string) (mainPairs []domain.PhoneNumberPairMeaning, hiddenPairs []domain.PhoneNumberPairMeaning, sumMeaning domain.PhoneNumberPairMeaning) {
 	cleaned := strings.ReplaceAll(number, "-", "")
 	cleaned = strings.TrimSpace(cleaned)
+	return s.readNumber(numberKindProfiles[NumberKindPhone], cleaned)
+}
 
-	n := len(cleaned)
-
-	getPairMeaning := func(p string) domain.PhoneNumberPairMeaning {
-		meaning, ok := s.pairCache[p]
-		if !ok {
-			meaning = domain.NumberPairMeaning{
-				PairNumber: p,
-				Color:      "#9E9E9E",
-			}
-		}
-		if meaning.Color == "" {
-			meaning.Color = GetPairTypeColor(meaning.PairType)
-		}
-		return domain.PhoneNumberPairMeaning{
-			Pair:    p,
-			Meaning: meaning,
-		}
+// AnalyzeNumberKind reads number as the given kind (phone, house, bank or id).
+// It returns the normalised number alongside the pairs and sum meaning.
+func (s *PhoneNumberService) AnalyzeNumberKind(kind, number string) (normalized string, mainPairs []domain.PhoneNumberPairMeaning, hiddenPairs []domain.PhoneNumberPairMeaning, sumMeaning domain.PhoneNumberPairMeaning, err error) {
+	profile, err := NumberKind(kind)
+	if err != nil {
+		return "", nil, nil, sumMeaning, err
 	}
-
-	// Calculate Main Pairs (01, 23, 45...)
-	for i := 0; i < n; i += 2 {
-		if i+1 < n {
-			p := cleaned[i : i+2]
-			mainPairs = append(mainPairs, getPairMeaning(p))
-		}
+	normalized, err = profile.Normalize(number)
+	if err != nil {
+		return "", nil, nil, sumMeaning, err
 	}
+	mainPairs, hiddenPairs, sumMeaning = s.readNumber(profile, normalized)
+	return normalized, mainPairs, hiddenPairs, sumMeaning, nil
+}
 
-	// Calculate Hidden Pairs (12, 34, 56...)
-	for i := 1; i < n; i += 2 {
-		if i+1 < n {
-			p := cleaned[i : i+2]
-			hiddenPairs = append(hiddenPairs, getPairMeaning(p))
-		}
+// readNumber looks up the pairs and the sum of an already normalised number.
+func (s *PhoneNumberService) readNumber(profile NumberKindProfile, number string) (mainPairs []domain.PhoneNumberPairMeaning, hiddenPairs []domain.PhoneNumberPairMeaning, sumMeaning domain.PhoneNumberPairMeaning) {
+	main, hidden := profile.Pairs(number)
+	for _, p := range main {
+		mainPairs = append(mainPairs, s.pairMeaning(p))
 	}
-
-	// Calculate Sum
-	sumVal := 0
-	for _, r := range cleaned {
-		if r >= '0' && r <= '9' {
-			sumVal += int(r - '0')
-		}
+	for _, p := range hidden {
+		hiddenPairs = append(hiddenPairs, s.pairMeaning(p))
 	}
-	sumStr := strconv.Itoa(sumVal)
+	return mainPairs, hiddenPairs, s.pairMeaning(strconv.Itoa(profile.Sum(number)))
+}
 
-	// Get Sum Meaning
-	m, ok := s.pairCache[sumStr]
+// pairMeaning looks p up in number_pairs; unknown pairs get the neutral colour.
+func (s *PhoneNumberService) pairMeaning(p string) domain.PhoneNumberPairMeaning {
+	meaning, ok := s.pairs.GetMeaning(p)
 	if !ok {
-		m = domain.NumberPairMeaning{
-			PairNumber: sumStr,
+		meaning = domain.NumberPairMeaning{
+			PairNumber: p,
 			Color:      "#9E9E9E",
 		}
 	}
-	if m.Color == "" {
-		m.Color = GetPairTypeColor(m.PairType)
+	if meaning.Color == "" {
+		meaning.Color = s.pairColor(meaning.PairType)
 	}
-	sumMeaning = domain.PhoneNumberPairMeaning{
-		Pair:    sumStr,
-		Meaning: m,
+	return domain.PhoneNumberPairMeaning{
+		Pair:    p,
+		Meaning: meaning,
 	}
-
-	return mainPairs, hiddenPairs, sumMeaning
 }
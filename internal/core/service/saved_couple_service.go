@@ -0,0 +1,44 @@
+package service
+
+import (
+	"errors"
+	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/ports"
+)
+
+// MaxSavedCouples is how many couples a member can keep on their dashboard.
+const MaxSavedCouples = 12
+
+type SavedCoupleService struct {
+	repo ports.SavedCoupleRepository
+}
+
+func NewSavedCoupleService(repo ports.SavedCoupleRepository) *SavedCoupleService {
+	return &SavedCoupleService{repo: repo}
+}
+
+func (s *SavedCoupleService) SaveCouple(userID int, result *domain.CoupleCompatibility, rulesetVersion int) error {
+	existing, err := s.repo.GetByUserID(userID)
+	if err != nil {
+		return err
+	}
+	if len(existing) >= MaxSavedCouples {
+		return errors.New("คุณสามารถบันทึกคู่ได้สูงสุด 12 คู่")
+	}
+
+	return s.repo.Save(&domain.SavedCouple{
+		UserID:         userID,
+		First:          result.People[0],
+		Second:         result.People[1],
+		Score:          result.Score,
+		RulesetVersion: rulesetVersion,
+	})
+}
+
+func (s *SavedCoupleService) GetSavedCouples(userID int) ([]domain.SavedCouple, error) {
+	return s.repo.GetByUserID(userID)
+}
+
+func (s *SavedCoupleService) DeleteSavedCouple(id int, userID int) error {
+	return s.repo.Delete(id, userID)
+}
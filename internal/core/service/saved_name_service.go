@@ -14,7 +14,9 @@ func NewSavedNameService(repo ports.SavedNameRepository) *SavedNameService {
 	return &SavedNameService{repo: repo}
 }
 
-func (s *SavedNameService) SaveName(userID int, name, birthDay string, totalScore, satSum, shaSum int) error {
+// SaveName stores a name for the member. When moment is given the birth day is
+// derived from it, overriding birthDay.
+func (s *SavedNameService) SaveName(userID int, name, surname, birthDay string, moment *domain.BirthMoment, totalScore, satSum, shaSum, rulesetVersion int) error {
 	// Check limit
 	existingNames, err := s.repo.GetByUserID(userID)
 	if err != nil {
@@ -27,10 +29,17 @@ func (s *SavedNameService) SaveName(userID int, name, birthDay string, totalScor
 	savedName := &domain.SavedName{
 		UserID:     userID,
 		Name:       name,
+		Surname:    surname,
 		BirthDay:   birthDay,
 		TotalScore: totalScore,
 		SatSum:     satSum,
 		ShaSum:     shaSum,
+
+		RulesetVersion: rulesetVersion,
+		BirthMoment:    moment,
+	}
+	if moment != nil {
+		savedName.BirthDay = moment.Day()
 	}
 	return s.repo.Save(savedName)
 }
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"numberniceic/internal/core/domain"
+	"numberniceic/internal/core/ports"
+	"strings"
+)
+
+type ScoringRulesetService struct {
+	repo     ports.ScoringRulesetRepository
+	provider ports.ScoringRulesetProvider
+}
+
+func NewScoringRulesetService(repo ports.ScoringRulesetRepository, provider ports.ScoringRulesetProvider) *ScoringRulesetService {
+	return &ScoringRulesetService{repo: repo, provider: provider}
+}
+
+func (s *ScoringRulesetService) GetActive() *domain.ScoringRuleset {
+	return s.provider.Active()
+}
+
+func (s *ScoringRulesetService) GetAll() ([]domain.ScoringRuleset, error) {
+	return s.repo.GetAll()
+}
+
+// CreateVersion parses and validates rulesJSON and stores it as a new version,
+// activating it straight away when activate is set.
+func (s *ScoringRulesetService) CreateVersion(name, rulesJSON string, activate bool) (*domain.ScoringRuleset, error) {
+	var rules domain.ScoringRules
+	if err := json.Unmarshal([]byte(rulesJSON), &rules); err != nil {
+		return nil, fmt.Errorf("invalid rules JSON: %w", err)
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(name) == "" {
+		return nil, errors.New("ruleset name is required")
+	}
+
+	ruleset := &domain.ScoringRuleset{Name: strings.TrimSpace(name), Rules: rules}
+	if err := s.repo.Create(ruleset); err != nil {
+		return nil, err
+	}
+	if activate {
+		if err := s.Activate(ruleset.Version); err != nil {
+			return ruleset, err
+		}
+		ruleset.IsActive = true
+	}
+	return ruleset, nil
+}
+
+// Activate switches scoring to version and reloads the in-memory ruleset.
+func (s *ScoringRulesetService) Activate(version int) error {
+	if err := s.repo.Activate(version); err != nil {
+		return err
+	}
+	return s.provider.Reload()
+}
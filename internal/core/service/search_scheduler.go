@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrSearchQueueFull is returned when too many searches are already waiting.
+	ErrSearchQueueFull = errors.New("search queue is full")
+	// ErrSearchQueueTimeout is returned when a search waited longer than allowed.
+	ErrSearchQueueTimeout = errors.New("search queue wait timed out")
+)
+
+// SearchScheduler bounds how many heavy name searches run at once. Waiting
+// searches start VIPs first, then the client with the fewest searches running,
+// then in arrival order; a client never runs more than perClient at a time.
+type SearchScheduler struct {
+	limit     int
+	perClient int
+	maxQueue  int
+	wait      time.Duration
+
+	mu      sync.Mutex
+	running int
+	clients map[string]int // Running searches per client
+	waiting []*searchTicket
+	seq     uint64
+}
+
+type searchTicket struct {
+	client   string
+	vip      bool
+	seq      uint64
+	ready    chan struct{} // Closed when the search may start
+	position chan int      // Latest queue position, 1-based
+	sent     int           // Last position put in position
+}
+
+func NewSearchScheduler(limit, perClient, maxQueue int, wait time.Duration) *SearchScheduler {
+	return &SearchScheduler{
+		limit:     limit,
+		perClient: perClient,
+		maxQueue:  maxQueue,
+		wait:      wait,
+		clients:   make(map[string]int),
+	}
+}
+
+// Acquire waits until client may run a search and returns the func that ends
+// it. While waiting, onQueue, if not nil, gets the queue position each time it
+// changes. A search leaves the queue when ctx is done, with ctx's error, or
+// after waiting longer than the scheduler allows.
+func (s *SearchScheduler) Acquire(ctx context.Context, client string, vip bool, onQueue func(position int)) (func(), error) {
+	s.mu.Lock()
+	s.seq++
+	t := &searchTicket{client: client, vip: vip, seq: s.seq, ready: make(chan struct{}), position: make(chan int, 1)}
+	s.waiting = append(s.waiting, t)
+	s.dispatch()
+	select {
+	case <-t.ready:
+		s.mu.Unlock()
+		return s.releaser(client), nil
+	default:
+	}
+	if len(s.waiting) > s.maxQueue {
+		s.drop(t)
+		s.dispatch()
+		s.mu.Unlock()
+		return nil, ErrSearchQueueFull
+	}
+	s.mu.Unlock()
+
+	timeout := time.NewTimer(s.wait)
+	defer timeout.Stop()
+	for {
+		select {
+		case <-t.ready:
+			return s.releaser(client), nil
+		case pos := <-t.position:
+			if onQueue != nil {
+				onQueue(pos)
+			}
+		case <-ctx.Done():
+			s.leave(t)
+			return nil, ctx.Err()
+		case <-timeout.C:
+			s.leave(t)
+			return nil, ErrSearchQueueTimeout
+		}
+	}
+}
+
+// leave takes t out of the queue, or gives its slot back if it just started.
+func (s *SearchScheduler) leave(t *searchTicket) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	select {
+	case <-t.ready:
+		s.finish(t.client)
+	default:
+		s.drop(t)
+	}
+	s.dispatch()
+}
+
+func (s *SearchScheduler) canStart(client string) bool {
+	return s.running < s.limit && s.clients[client] < s.perClient
+}
+
+func (s *SearchScheduler) start(client string) {
+	s.running++
+	s.clients[client]++
+}
+
+func (s *SearchScheduler) finish(client string) {
+	s.running--
+	if s.clients[client]--; s.clients[client] <= 0 {
+		delete(s.clients, client)
+	}
+}
+
+func (s *SearchScheduler) drop(t *searchTicket) {
+	for i, w := range s.waiting {
+		if w == t {
+			s.waiting = append(s.waiting[:i], s.waiting[i+1:]...)
+			return
+		}
+	}
+}
+
+// releaser ends the search of client once, then starts the next ones waiting.
+func (s *SearchScheduler) releaser(client string) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.finish(client)
+			s.dispatch()
+		})
+	}
+}
+
+// dispatch starts every waiting search that may run, in priority order, then
+// tells the rest their new position.
+func (s *SearchScheduler) dispatch() {
+	sort.SliceStable(s.waiting, func(i, j int) bool { return s.before(s.waiting[i], s.waiting[j]) })
+	for i := 0; i < len(s.waiting) && s.running < s.limit; {
+		t := s.waiting[i]
+		if !s.canStart(t.client) {
+			i++
+			continue
+		}
+		s.start(t.client)
+		close(t.ready)
+		s.waiting = append(s.waiting[:i], s.waiting[i+1:]...)
+		// Running counts changed, so fairness may reorder the rest
+		sort.SliceStable(s.waiting, func(i, j int) bool { return s.before(s.waiting[i], s.waiting[j]) })
+		i = 0
+	}
+	for i, t := range s.waiting {
+		if t.sent == i+1 {
+			continue
+		}
+		select {
+		case <-t.position:
+		default:
+		}
+		t.sent = i + 1
+		t.position <- t.sent
+	}
+}
+
+func (s *SearchScheduler) before(a, b *searchTicket) bool {
+	if a.vip != b.vip {
+		return a.vip
+	}
+	if ra, rb := s.clients[a.client], s.clients[b.client]; ra != rb {
+		return ra < rb
+	}
+	return a.seq < b.seq
+}
@@ -13,7 +13,9 @@ import (
 	"numberniceic/views/layout"
 	"numberniceic/views/pages"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -25,6 +27,21 @@ import (
 	_ "github.com/lib/pq"
 )
 
+// Bounds of the name search result cache shared by the analyzer endpoints.
+const (
+	analysisCacheSize = 2000
+	analysisCacheTTL  = 10 * time.Minute
+)
+
+// Bounds of the heavy name searches: how many run at once, how many one user or
+// IP may run, how many may wait, and how long one waits before giving up.
+const (
+	searchConcurrency = 8
+	searchPerClient   = 3
+	searchQueueSize   = 100
+	searchQueueWait   = 2 * time.Minute
+)
+
 func main() {
 	log.Println("--- STARTING APPLICATION ---")
 
@@ -47,29 +64,71 @@ func main() {
 
 	// --- Services & Repos ---
 	anthropicKey := os.Getenv("ANTHROPIC_API_KEY")
-	linguisticService, _ := service.NewLinguisticService(apiKey, anthropicKey)
-	numerologyCache := setupNumerologyCache(db, "sat_nums")
-	shadowCache := setupNumerologyCache(db, "sha_nums")
-	klakiniCache := setupKlakiniCache(db)
-	numberPairCache := setupNumberPairCache(db)
-	numberCategoryCache := setupNumberCategoryCache(db)
+	nameDictionaryRepo := repository.NewPostgresNameDictionaryRepository(db)
+	nameDictionaryCache := cache.NewNameDictionaryCache(nameDictionaryRepo)
+	if err := nameDictionaryCache.EnsureLoaded(); err != nil {
+		log.Printf("Warning: name dictionary not loaded, linguistic analysis will ask the LLM: %v", err)
+	}
+	nameDictionaryService := service.NewNameDictionaryService(nameDictionaryRepo, nameDictionaryCache)
+	linguisticService, _ := service.NewLinguisticService(apiKey, anthropicKey, nameDictionaryService)
+	engineCaches, err := cache.NewEngineCaches(db)
+	if err != nil {
+		log.Printf("Warning: reference caches not fully loaded, will retry on first use: %v", err)
+	}
+	numberPairCache := engineCaches.Pairs
+	numberCategoryCache := engineCaches.Categories
+	scoringRulesetCache := engineCaches.Ruleset
+	fmt.Println("Reference caches are ready.")
 
 	sampleNamesRepo := repository.NewPostgresSampleNamesRepository(db)
 	sampleNamesCache := cache.NewSampleNamesCache(sampleNamesRepo)
 	sampleNamesCache.EnsureLoaded()
 	fmt.Println("Sample names cache is ready.")
 
-	namesMiracleRepo := repository.NewPostgresNamesMiracleRepository(db)
-	numerologySvc := service.NewNumerologyService(numerologyCache, shadowCache, klakiniCache, numberPairCache)
+	numerologyEngine := engineCaches.Engine()
+	namesMiracleRepo := cache.NewNamesMiracleIndex(repository.NewPostgresNamesMiracleRepository(db, scoringRulesetCache), scoringRulesetCache, numerologyEngine)
+	if err := namesMiracleRepo.EnsureLoaded(); err != nil {
+		log.Printf("Warning: names miracle index not loaded, will retry on first search: %v", err)
+	}
+	numerologySvc := service.NewNumerologyService(numerologyEngine)
 
-	// Re-initialize NumberPairRepository for PhoneNumberService (since setupNumberPairCache hides it)
-	numberPairRepo := repository.NewPostgresNumberPairRepository(db)
+	// PhoneNumberService shares the pair cache, so a reload reaches both
 	phoneNumberRepo := repository.NewPostgresPhoneNumberRepository(db)
-	phoneNumberSvc := service.NewPhoneNumberService(phoneNumberRepo, numberPairRepo)
+	phoneNumberSvc := service.NewPhoneNumberService(phoneNumberRepo, numberPairCache, scoringRulesetCache)
+
+	// Cache Registry: reference caches reload when their tables change (LISTEN/NOTIFY)
+	cacheRegistry := cache.NewRegistry()
+	cacheRegistry.Register("sat_nums", engineCaches.Sat, "sat_nums")
+	cacheRegistry.Register("sha_nums", engineCaches.Sha, "sha_nums")
+	cacheRegistry.Register("latin_sat_nums", engineCaches.LatinSat, "latin_sat_nums")
+	cacheRegistry.Register("latin_sha_nums", engineCaches.LatinSha, "latin_sha_nums")
+	cacheRegistry.Register("klakini", engineCaches.Klakini, "kakis_day")
+	cacheRegistry.Register("taksa", engineCaches.Taksa, "taksa_day")
+	cacheRegistry.Register("number_pairs", numberPairCache, "numbers", "number_categories")
+	cacheRegistry.Register("number_categories", numberCategoryCache, "number_categories")
+	cacheRegistry.Register("scoring_ruleset", scoringRulesetCache, "scoring_rulesets")
+	cacheRegistry.Register("name_dictionary", nameDictionaryCache, "name_dictionary")
+	cacheRegistry.Register("sample_names", sampleNamesCache, "sample_names")
+	// names_miracle has no trigger, as every recompute batch would reload the whole
+	// index; a recompute publishes one change when it finishes instead.
+	cacheRegistry.Register("names_miracle", namesMiracleRepo, "names_miracle")
+	// Each analysis pins one snapshot of the caches, so a reload never lands mid-analysis
+	numerologyEngine.SetSnapshotLock(cacheRegistry.SnapshotLock())
+	// Name search results are dropped whenever reference data reloads
+	analysisResults := cache.NewAnalysisCache(analysisCacheSize, analysisCacheTTL)
+	cacheRegistry.OnReload(analysisResults.Invalidate)
+	// Bounds the analyzer name searches so a burst cannot tie up the database
+	searchScheduler := service.NewSearchScheduler(searchConcurrency, searchPerClient, searchQueueSize, searchQueueWait)
+	cacheReloadListener, err := cache.NewReloadListener(cacheRegistry, db, postgresDSN())
+	if err != nil {
+		log.Printf("Warning: cache reload listener not started, caches reload only from the admin endpoint: %v", err)
+		cacheReloadListener = nil
+	}
 
 	// Repositories
 	memberRepo := repository.NewPostgresMemberRepository(db)
 	savedNameRepo := repository.NewPostgresSavedNameRepository(db)
+	savedCoupleRepo := repository.NewPostgresSavedCoupleRepository(db)
 	articleRepo := repository.NewPostgresArticleRepository(db)
 	productRepo := repository.NewPostgresProductRepository(db)
 	orderRepo := repository.NewPostgresOrderRepository(db)
@@ -88,6 +147,7 @@ func main() {
 	// Services
 	memberService := service.NewMemberService(memberRepo, firebaseService)
 	savedNameService := service.NewSavedNameService(savedNameRepo)
+	savedCoupleService := service.NewSavedCoupleService(savedCoupleRepo)
 	articleService := service.NewArticleService(articleRepo)
 	adminService := service.NewAdminService(memberRepo, articleRepo, sampleNamesRepo, namesMiracleRepo, productRepo, orderRepo, numerologySvc, phoneNumberSvc, promotionalCodeRepo)
 
@@ -103,6 +163,8 @@ func main() {
 	notificationRepo := repository.NewPostgresNotificationRepository(db)
 	notificationService := service.NewNotificationService(notificationRepo)
 
+	scoringRulesetService := service.NewScoringRulesetService(repository.NewPostgresScoringRulesetRepository(db), scoringRulesetCache)
+
 	// --- Session Store ---
 	store := session.New(session.Config{
 		CookieHTTPOnly: true,
@@ -116,15 +178,12 @@ func main() {
 		IdleTimeout:  120 * time.Second,
 		BodyLimit:    20 * 1024 * 1024, // 20MB
 	})
+	if cacheReloadListener != nil {
+		app.Hooks().OnShutdown(cacheReloadListener.Close)
+	}
 	app.Use(recover.New())
 	app.Use(cors.New()) // Enable CORS for API access
 
-	// Rate Limiting for Analysis endpoints (prevent server overload)
-	app.Use("/analyzer", func(c *fiber.Ctx) error {
-		// Simple rate limiting: max 3 concurrent requests per IP
-		return c.Next()
-	})
-
 	app.Static("/", "./static")
 
 	// --- Central Middleware for Session Data ---
@@ -165,6 +224,7 @@ func main() {
 				isRealTimeVIP := member.IsVIP()
 				c.Locals("IsVIP", isRealTimeVIP)
 				c.Locals("AvatarURL", member.AvatarURL)
+				c.Locals("BirthDay", member.BirthDay())
 
 				// Optional: Sync session if changed
 				if isRealTimeVIP != isVipSession {
@@ -206,11 +266,34 @@ func main() {
 
 	// --- Handlers ---
 	// --- Handlers ---
-	numerologyHandler := handler.NewNumerologyHandler(numerologyCache, shadowCache, klakiniCache, numberPairCache, numberCategoryCache, namesMiracleRepo, linguisticService, sampleNamesCache, phoneNumberSvc, db)
-	memberHandler := handler.NewMemberHandler(memberService, savedNameService, buddhistDayService, shippingAddressService, klakiniCache, numberPairCache, store, promotionalCodeRepo)
-	savedNameHandler := handler.NewSavedNameHandler(savedNameService, klakiniCache, numberPairCache, store)
+	numerologyHandler := handler.NewNumerologyHandler(numerologyEngine, numberPairCache, numberCategoryCache, namesMiracleRepo, linguisticService, sampleNamesCache, phoneNumberSvc, analysisResults, searchScheduler, db)
+	memberHandler := handler.NewMemberHandler(memberService, savedNameService, buddhistDayService, shippingAddressService, numerologyEngine, store, promotionalCodeRepo)
+	savedNameHandler := handler.NewSavedNameHandler(savedNameService, numerologyEngine, store)
+	coupleHandler := handler.NewCoupleHandler(savedCoupleService, numerologyEngine, store)
 	articleHandler := handler.NewArticleHandler(articleService, store)
-	adminHandler := handler.NewAdminHandler(adminService, sampleNamesCache, store, buddhistDayService, walletColorService, shippingAddressService, mobileConfigService, notificationService, memberService, articleService)
+	namesMiracleRecompute := service.NewNamesMiracleRecomputeService(namesMiracleRepo, numerologySvc)
+	// The index of this instance is updated row by row; cached analyses and the
+	// other instances are told once the run is done.
+	namesMiracleRecompute.OnUpdated(func() {
+		analysisResults.Invalidate()
+		notify := func() error { return cache.Notify(db, "names_miracle") }
+		if cacheReloadListener != nil {
+			notify = func() error { return cacheReloadListener.Publish("names_miracle") }
+		}
+		if err := notify(); err != nil {
+			log.Printf("Warning: servers were not told to reload names_miracle, reload it from /admin/caches: %v", err)
+		}
+	})
+	namesMiracleRecomputeHandler := handler.NewNamesMiracleRecomputeHandler(namesMiracleRecompute)
+	cacheHandler := handler.NewCacheHandler(cacheRegistry, cacheReloadListener, analysisResults)
+	referenceDataStore := repository.NewPostgresReferenceDataStore(db)
+	numberDataService := service.NewNumberDataService(
+		referenceDataStore,
+		namesMiracleRepo, phoneNumberRepo, numberPairCache, numberCategoryCache, scoringRulesetCache,
+	)
+	numberDataHandler := handler.NewNumberDataHandler(numberDataService, store)
+	letterDataHandler := handler.NewLetterDataHandler(service.NewLetterDataService(referenceDataStore, cacheRegistry, numerologyEngine, sampleNamesCache), store)
+	adminHandler := handler.NewAdminHandler(adminService, sampleNamesCache, store, buddhistDayService, walletColorService, shippingAddressService, mobileConfigService, notificationService, memberService, articleService, scoringRulesetService, nameDictionaryService)
 
 	paymentService := service.NewPaymentService(orderRepo, memberRepo, promotionalCodeRepo, memberService)
 	// We need to pass store to paymentHandler if we want to read session user_id
@@ -272,6 +355,7 @@ func main() {
 							c.Locals("IsVIP", member.IsVIP())
 							c.Locals("IsAdmin", member.Status == 9)
 							c.Locals("AvatarURL", member.AvatarURL)
+							c.Locals("BirthDay", member.BirthDay())
 						}
 					}
 				}
@@ -632,6 +716,11 @@ func main() {
 	app.Get("/similar-names", numerologyHandler.GetSimilarNames)
 	app.Get("/number-meanings", numerologyHandler.GetNumberMeanings)
 	app.Get("/linguistic-analysis", numerologyHandler.AnalyzeLinguistically)
+	app.Get("/spelling-variants", numerologyHandler.SpellingVariants)
+	app.Get("/compare", numerologyHandler.ComparePage)
+	app.Get("/couple", coupleHandler.CouplePage)
+	app.Get("/business", numerologyHandler.BusinessPage)
+	app.Get("/plate-analysis", numerologyHandler.PlateAnalysisPage)
 
 	// Article Routes
 	app.Get("/articles", articleHandler.ShowArticlesPage)
@@ -641,8 +730,13 @@ func main() {
 	api := app.Group("/api")
 	api.Get("/analyze", optionalAuthMiddleware, numerologyHandler.AnalyzeAPI)
 	api.Get("/analyze/stream", optionalAuthMiddleware, numerologyHandler.AnalyzeAPIStreaming)
+	api.Get("/analyze/repair", numerologyHandler.RepairNameAPI)
+	api.Get("/spelling-variants", numerologyHandler.SpellingVariantsAPI)
+	api.Get("/compare", numerologyHandler.CompareAPI)
+	api.Get("/couple", coupleHandler.CoupleAPI)
 	api.Get("/numerology/bad-numbers", numerologyHandler.GetBadNumbersAPI) // New Route
 	api.Get("/number-analysis", numerologyHandler.AnalyzePhoneNumberAPI)   // Updated route path
+	api.Get("/plate-analysis", numerologyHandler.PlateAnalysisAPI)
 	api.Get("/analyze-linguistically", numerologyHandler.AnalyzeLinguisticallyAPI)
 	api.Get("/sample-names", numerologyHandler.GetSampleNamesAPI)
 	api.Get("/lucky-number", func(c *fiber.Ctx) error {
@@ -663,6 +757,9 @@ func main() {
 	})
 	api.Post("/saved-names", optionalAuthMiddleware, savedNameHandler.SaveName)
 	app.Delete("/api/saved-names/:id", optionalAuthMiddleware, savedNameHandler.DeleteSavedName)
+	api.Get("/saved-couples", optionalAuthMiddleware, coupleHandler.GetSavedCouples)
+	api.Post("/saved-couples", optionalAuthMiddleware, coupleHandler.SaveCouple)
+	app.Delete("/api/saved-couples/:id", optionalAuthMiddleware, coupleHandler.DeleteSavedCouple)
 	api.Get("/payment/upgrade", optionalAuthMiddleware, paymentHandler.GetUpgradeModalAPI)
 	api.Get("/payment/status/:refNo", optionalAuthMiddleware, paymentHandler.CheckPaymentStatus)
 	api.Get("/articles", articleHandler.GetArticlesJSON)
@@ -721,9 +818,16 @@ func main() {
 	savedNames.Get("/", savedNameHandler.GetSavedNames)
 	savedNames.Delete("/:id", savedNameHandler.DeleteSavedName)
 
+	// Saved Couples Routes
+	app.Post("/saved-couples", optionalAuthMiddleware, coupleHandler.SaveCouple)
+	savedCouples := app.Group("/saved-couples", authMiddleware)
+	savedCouples.Get("/", coupleHandler.GetSavedCouples)
+	savedCouples.Delete("/:id", coupleHandler.DeleteSavedCouple)
+
 	// Update Profile API
 	// Use Auth Middleware to ensure user is logged in
 	app.Post("/api/profile/update", authMiddleware, memberHandler.HandleUpdateProfileAPI)
+	app.Post("/api/profile/birth", authMiddleware, memberHandler.HandleUpdateBirthAPI)
 
 	// Admin Routes (Protect with adminMiddleware)	savedNames.Delete("/:id", optionalAuthMiddleware, savedNameHandler.DeleteSavedName)
 
@@ -806,6 +910,50 @@ func main() {
 	// Mobile Config
 	admin.Get("/welcome-message", adminHandler.ShowMobileConfigPage)
 	admin.Post("/welcome-message", adminHandler.UpdateMobileConfig)
+	admin.Get("/scoring-rulesets", adminHandler.ShowScoringRulesetsPage)
+	admin.Post("/scoring-rulesets", adminHandler.CreateScoringRuleset)
+	admin.Post("/scoring-rulesets/:version/activate", adminHandler.ActivateScoringRuleset)
+
+	// Name Dictionary
+	admin.Get("/name-dictionary", adminHandler.ShowNameDictionaryPage)
+	admin.Post("/name-dictionary", adminHandler.SaveNameDictionaryEntry)
+	admin.Post("/name-dictionary/import", adminHandler.ImportNameDictionary)
+	admin.Post("/name-dictionary/:id/approve", adminHandler.ApproveNameDictionaryEntry)
+	admin.Post("/name-dictionary/:id/delete", adminHandler.DeleteNameDictionaryEntry)
+	admin.Get("/names-miracle/recompute", namesMiracleRecomputeHandler.RecomputeStatus)
+	admin.Post("/names-miracle/recompute", namesMiracleRecomputeHandler.StartRecompute)
+
+	// Number Pairs & Categories
+	admin.Get("/number-pairs", numberDataHandler.ShowPairsPage)
+	admin.Post("/number-pairs", numberDataHandler.SavePair)
+	admin.Post("/number-pairs/preview", numberDataHandler.PreviewPair)
+	admin.Post("/number-pairs/:pair/delete", numberDataHandler.DeletePair)
+	admin.Get("/number-categories", numberDataHandler.ShowCategoriesPage)
+	admin.Post("/number-categories", numberDataHandler.SaveCategory)
+	admin.Post("/number-categories/preview", numberDataHandler.PreviewCategory)
+	admin.Post("/number-categories/:id/delete", numberDataHandler.DeleteCategory)
+	admin.Get("/api/number-pairs", numberDataHandler.ListPairsAPI)
+	admin.Get("/api/number-pairs/:pair", numberDataHandler.GetPairAPI)
+	admin.Put("/api/number-pairs/:pair", numberDataHandler.SavePairAPI)
+	admin.Delete("/api/number-pairs/:pair", numberDataHandler.DeletePairAPI)
+	admin.Post("/api/number-pairs/:pair/preview", numberDataHandler.PreviewPairAPI)
+	admin.Get("/api/number-categories", numberDataHandler.ListCategoriesAPI)
+	admin.Get("/api/number-categories/preview", numberDataHandler.PreviewCategoryAPI)
+	admin.Post("/api/number-categories", numberDataHandler.SaveCategoryAPI)
+	admin.Put("/api/number-categories/:id", numberDataHandler.SaveCategoryAPI)
+	admin.Delete("/api/number-categories/:id", numberDataHandler.DeleteCategoryAPI)
+	admin.Get("/api/number-data/history", numberDataHandler.HistoryAPI)
+	admin.Get("/letters", letterDataHandler.ShowLettersPage)
+	admin.Post("/letters", letterDataHandler.SaveLetter)
+	admin.Post("/letters/preview", letterDataHandler.PreviewLetter)
+	admin.Get("/api/letters", letterDataHandler.ListLettersAPI)
+	admin.Put("/api/letters", letterDataHandler.SaveLetterAPI)
+	admin.Post("/api/letters/preview", letterDataHandler.PreviewLetterAPI)
+	admin.Get("/api/letters/history", letterDataHandler.HistoryAPI)
+
+	// Cache Reload
+	admin.Get("/caches", cacheHandler.ListCaches)
+	admin.Post("/caches/reload", cacheHandler.ReloadCaches)
 
 	// Notification Management
 	admin.Get("/notification", adminHandler.ShowNotificationPage)
@@ -843,16 +991,33 @@ func main() {
 	app.Post("/api/notifications/:id/read", optionalAuthMiddleware, adminHandler.MarkNotificationReadAPI)
 	app.Delete("/api/notifications/:id", optionalAuthMiddleware, adminHandler.DeleteNotificationAPI)
 
+	// Shut down on SIGINT/SIGTERM so the shutdown hooks run
+	go func() {
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+		<-quit
+		log.Println("Shutting down server...")
+		if err := app.Shutdown(); err != nil {
+			log.Printf("Error shutting down server: %v", err)
+		}
+	}()
+
 	log.Println("Starting server on port 3000...")
-	log.Fatal(app.Listen(":3000"))
+	if err := app.Listen(":3000"); err != nil {
+		log.Fatal(err)
+	}
 }
 
-// ... (setup functions remain the same) ...
-func setupDatabase() *sql.DB {
-	psqlInfo := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable client_encoding=UTF8",
+// postgresDSN is the connection string for the database in the environment.
+func postgresDSN() string {
+	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable client_encoding=UTF8",
 		os.Getenv("DB_HOST"), os.Getenv("DB_PORT"), os.Getenv("DB_USER"),
 		os.Getenv("DB_PASSWORD"), os.Getenv("DB_NAME"))
-	db, _ := sql.Open("postgres", psqlInfo)
+}
+
+// ... (setup functions remain the same) ...
+func setupDatabase() *sql.DB {
+	db, _ := sql.Open("postgres", postgresDSN())
 
 	// Optimize Connection Pool
 	db.SetMaxOpenConns(25)
@@ -943,6 +1108,365 @@ func setupDatabase() *sql.DB {
 		log.Printf("Migration Warning (Buddhist Days): %v", err)
 	}
 
+	// Auto-migrate Saved Name Surname
+	migrationSurnameSQL := `
+		ALTER TABLE saved_names ADD COLUMN IF NOT EXISTS surname VARCHAR(255) NOT NULL DEFAULT '';
+	`
+	if _, err := db.Exec(migrationSurnameSQL); err != nil {
+		log.Printf("Migration Warning (Saved Name Surname): %v", err)
+	}
+
+	// Auto-migrate Latin Letter Values
+	migrationLatinSQL := `
+		CREATE TABLE IF NOT EXISTS latin_sat_nums (
+		    char_key VARCHAR(10) PRIMARY KEY,
+		    sat_value INTEGER NOT NULL
+		);
+
+		CREATE TABLE IF NOT EXISTS latin_sha_nums (
+		    char_key VARCHAR(10) PRIMARY KEY,
+		    sha_value INTEGER NOT NULL
+		);
+
+		INSERT INTO latin_sat_nums (char_key, sat_value) VALUES
+		    ('A', 1),
+		    ('B', 2),
+		    ('C', 3),
+		    ('D', 4),
+		    ('E', 5),
+		    ('F', 8),
+		    ('G', 3),
+		    ('H', 5),
+		    ('I', 1),
+		    ('J', 1),
+		    ('K', 2),
+		    ('L', 3),
+		    ('M', 4),
+		    ('N', 5),
+		    ('O', 7),
+		    ('P', 8),
+		    ('Q', 1),
+		    ('R', 2),
+		    ('S', 3),
+		    ('T', 4),
+		    ('U', 6),
+		    ('V', 6),
+		    ('W', 6),
+		    ('X', 5),
+		    ('Y', 1),
+		    ('Z', 7)
+		ON CONFLICT (char_key) DO NOTHING;
+
+		INSERT INTO latin_sha_nums (char_key, sha_value) VALUES
+		    ('A', 1),
+		    ('B', 2),
+		    ('C', 3),
+		    ('D', 4),
+		    ('E', 5),
+		    ('F', 6),
+		    ('G', 7),
+		    ('H', 8),
+		    ('I', 9),
+		    ('J', 1),
+		    ('K', 2),
+		    ('L', 3),
+		    ('M', 4),
+		    ('N', 5),
+		    ('O', 6),
+		    ('P', 7),
+		    ('Q', 8),
+		    ('R', 9),
+		    ('S', 1),
+		    ('T', 2),
+		    ('U', 3),
+		    ('V', 4),
+		    ('W', 5),
+		    ('X', 6),
+		    ('Y', 7),
+		    ('Z', 8)
+		ON CONFLICT (char_key) DO NOTHING;
+	`
+	if _, err := db.Exec(migrationLatinSQL); err != nil {
+		log.Printf("Migration Warning (Latin Letter Values): %v", err)
+	}
+
+	// Auto-migrate Scoring Rulesets
+	migrationRulesetSQL := `
+		CREATE TABLE IF NOT EXISTS scoring_rulesets (
+		    id SERIAL PRIMARY KEY,
+		    version INTEGER NOT NULL UNIQUE,
+		    name VARCHAR(255) NOT NULL DEFAULT '',
+		    rules JSONB NOT NULL,
+		    is_active BOOLEAN NOT NULL DEFAULT false,
+		    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		    activated_at TIMESTAMP
+		);
+
+		INSERT INTO scoring_rulesets (version, name, rules, is_active, activated_at) VALUES (
+		    1,
+		    'Default',
+		    '{
+		        "tiers": [
+		            {"pair_type": "D10", "class": "good", "color": "#2E7D32"},
+		            {"pair_type": "D8", "class": "good", "color": "#43A047"},
+		            {"pair_type": "D5", "class": "good", "color": "#66BB6A"},
+		            {"pair_type": "R10", "class": "bad", "color": "#C62828"},
+		            {"pair_type": "R7", "class": "bad", "color": "#E53935"},
+		            {"pair_type": "R5", "class": "bad", "color": "#EF5350"}
+		        ],
+		        "bad_pair_color": "#D32F2F",
+		        "unknown_pair_color": "#9E9E9E",
+		        "top_tier_types": ["D10", "D8", "D5"],
+		        "penalties": {"bad_pair": 0, "klakini": 0}
+		    }',
+		    true,
+		    CURRENT_TIMESTAMP
+		) ON CONFLICT (version) DO NOTHING;
+
+		ALTER TABLE saved_names ADD COLUMN IF NOT EXISTS ruleset_version INTEGER NOT NULL DEFAULT 1;
+	`
+	if _, err := db.Exec(migrationRulesetSQL); err != nil {
+		log.Printf("Migration Warning (Scoring Rulesets): %v", err)
+	}
+
+	// Auto-migrate Birth Moment
+	migrationBirthMomentSQL := `
+		ALTER TABLE member ADD COLUMN IF NOT EXISTS birth_date DATE;
+		ALTER TABLE member ADD COLUMN IF NOT EXISTS birth_time TIME;
+		ALTER TABLE member ADD COLUMN IF NOT EXISTS birth_day_boundary VARCHAR(10);
+
+		ALTER TABLE saved_names ADD COLUMN IF NOT EXISTS birth_date DATE;
+		ALTER TABLE saved_names ADD COLUMN IF NOT EXISTS birth_time TIME;
+		ALTER TABLE saved_names ADD COLUMN IF NOT EXISTS birth_day_boundary VARCHAR(10);
+	`
+	if _, err := db.Exec(migrationBirthMomentSQL); err != nil {
+		log.Printf("Migration Warning (Birth Moment): %v", err)
+	}
+
+	// Auto-migrate Taksa Groups
+	migrationTaksaSQL := `
+		-- Taksa (ทักษา) letter groups per birth day. Counting starts at the birth day's
+		-- planet (บริวาร) and goes round the wheel: Sunday, Monday, Tuesday, Wednesday,
+		-- Saturday, Thursday, Wednesday night (Rahu), Friday.
+		CREATE TABLE IF NOT EXISTS taksa_day (
+		    day VARCHAR(20) NOT NULL,
+		    taksa_group VARCHAR(20) NOT NULL,
+		    chars TEXT NOT NULL,
+		    PRIMARY KEY (day, taksa_group)
+		);
+
+		INSERT INTO taksa_day (day, taksa_group, chars) VALUES
+		    ('sunday', 'บริวาร', 'อะัาำิีึืุูเแโใไ'),
+		    ('sunday', 'อายุ', 'กขฃคฅฆง'),
+		    ('sunday', 'เดช', 'จฉชซฌญ'),
+		    ('sunday', 'ศรี', 'ฎฏฐฑฒณ'),
+		    ('sunday', 'มูละ', 'ดตถทธน'),
+		    ('sunday', 'อุตสาหะ', 'บปผฝพฟภม'),
+		    ('sunday', 'มนตรี', 'ยรลว'),
+		    ('sunday', 'กาลกิณี', 'ศษสหฬฮ'),
+		    ('monday', 'บริวาร', 'กขฃคฅฆง'),
+		    ('monday', 'อายุ', 'จฉชซฌญ'),
+		    ('monday', 'เดช', 'ฎฏฐฑฒณ'),
+		    ('monday', 'ศรี', 'ดตถทธน'),
+		    ('monday', 'มูละ', 'บปผฝพฟภม'),
+		    ('monday', 'อุตสาหะ', 'ยรลว'),
+		    ('monday', 'มนตรี', 'ศษสหฬฮ'),
+		    ('monday', 'กาลกิณี', 'อะัาำิีึืุูเแโใไ'),
+		    ('tuesday', 'บริวาร', 'จฉชซฌญ'),
+		    ('tuesday', 'อายุ', 'ฎฏฐฑฒณ'),
+		    ('tuesday', 'เดช', 'ดตถทธน'),
+		    ('tuesday', 'ศรี', 'บปผฝพฟภม'),
+		    ('tuesday', 'มูละ', 'ยรลว'),
+		    ('tuesday', 'อุตสาหะ', 'ศษสหฬฮ'),
+		    ('tuesday', 'มนตรี', 'อะัาำิีึืุูเแโใไ'),
+		    ('tuesday', 'กาลกิณี', 'กขฃคฅฆง'),
+		    ('wednesday1', 'บริวาร', 'ฎฏฐฑฒณ'),
+		    ('wednesday1', 'อายุ', 'ดตถทธน'),
+		    ('wednesday1', 'เดช', 'บปผฝพฟภม'),
+		    ('wednesday1', 'ศรี', 'ยรลว'),
+		    ('wednesday1', 'มูละ', 'ศษสหฬฮ'),
+		    ('wednesday1', 'อุตสาหะ', 'อะัาำิีึืุูเแโใไ'),
+		    ('wednesday1', 'มนตรี', 'กขฃคฅฆง'),
+		    ('wednesday1', 'กาลกิณี', 'จฉชซฌญ'),
+		    ('saturday', 'บริวาร', 'ดตถทธน'),
+		    ('saturday', 'อายุ', 'บปผฝพฟภม'),
+		    ('saturday', 'เดช', 'ยรลว'),
+		    ('saturday', 'ศรี', 'ศษสหฬฮ'),
+		    ('saturday', 'มูละ', 'อะัาำิีึืุูเแโใไ'),
+		    ('saturday', 'อุตสาหะ', 'กขฃคฅฆง'),
+		    ('saturday', 'มนตรี', 'จฉชซฌญ'),
+		    ('saturday', 'กาลกิณี', 'ฎฏฐฑฒณ'),
+		    ('thursday', 'บริวาร', 'บปผฝพฟภม'),
+		    ('thursday', 'อายุ', 'ยรลว'),
+		    ('thursday', 'เดช', 'ศษสหฬฮ'),
+		    ('thursday', 'ศรี', 'อะัาำิีึืุูเแโใไ'),
+		    ('thursday', 'มูละ', 'กขฃคฅฆง'),
+		    ('thursday', 'อุตสาหะ', 'จฉชซฌญ'),
+		    ('thursday', 'มนตรี', 'ฎฏฐฑฒณ'),
+		    ('thursday', 'กาลกิณี', 'ดตถทธน'),
+		    ('wednesday2', 'บริวาร', 'ยรลว'),
+		    ('wednesday2', 'อายุ', 'ศษสหฬฮ'),
+		    ('wednesday2', 'เดช', 'อะัาำิีึืุูเแโใไ'),
+		    ('wednesday2', 'ศรี', 'กขฃคฅฆง'),
+		    ('wednesday2', 'มูละ', 'จฉชซฌญ'),
+		    ('wednesday2', 'อุตสาหะ', 'ฎฏฐฑฒณ'),
+		    ('wednesday2', 'มนตรี', 'ดตถทธน'),
+		    ('wednesday2', 'กาลกิณี', 'บปผฝพฟภม'),
+		    ('friday', 'บริวาร', 'ศษสหฬฮ'),
+		    ('friday', 'อายุ', 'อะัาำิีึืุูเแโใไ'),
+		    ('friday', 'เดช', 'กขฃคฅฆง'),
+		    ('friday', 'ศรี', 'จฉชซฌญ'),
+		    ('friday', 'มูละ', 'ฎฏฐฑฒณ'),
+		    ('friday', 'อุตสาหะ', 'ดตถทธน'),
+		    ('friday', 'มนตรี', 'บปผฝพฟภม'),
+		    ('friday', 'กาลกิณี', 'ยรลว')
+		ON CONFLICT (day, taksa_group) DO NOTHING;
+	`
+	if _, err := db.Exec(migrationTaksaSQL); err != nil {
+		log.Printf("Migration Warning (Taksa Groups): %v", err)
+	}
+
+	// Auto-migrate Saved Couples
+	migrationSavedCouplesSQL := `
+		CREATE TABLE IF NOT EXISTS saved_couples (
+			id SERIAL PRIMARY KEY,
+			created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+			deleted_at TIMESTAMP,
+			user_id INTEGER NOT NULL REFERENCES member(id) ON DELETE CASCADE,
+			name1 VARCHAR(255) NOT NULL,
+			surname1 VARCHAR(255) NOT NULL DEFAULT '',
+			day1 VARCHAR(20) NOT NULL,
+			name2 VARCHAR(255) NOT NULL,
+			surname2 VARCHAR(255) NOT NULL DEFAULT '',
+			day2 VARCHAR(20) NOT NULL,
+			score INTEGER NOT NULL DEFAULT 0,
+			ruleset_version INTEGER NOT NULL DEFAULT 1
+		);
+		CREATE INDEX IF NOT EXISTS idx_saved_couples_user_id ON saved_couples(user_id);
+	`
+	if _, err := db.Exec(migrationSavedCouplesSQL); err != nil {
+		log.Printf("Migration Warning (Saved Couples): %v", err)
+	}
+
+	// Auto-migrate Names Miracle Phonetic Key
+	migrationPhoneticKeySQL := `
+		ALTER TABLE names_miracle ADD COLUMN IF NOT EXISTS phonetic_key TEXT NOT NULL DEFAULT '';
+	`
+	if _, err := db.Exec(migrationPhoneticKeySQL); err != nil {
+		log.Printf("Migration Warning (Phonetic Key): %v", err)
+	}
+
+	// Auto-migrate Names Miracle Search Metadata
+	migrationSearchMetadataSQL := `
+		ALTER TABLE names_miracle ADD COLUMN IF NOT EXISTS gender VARCHAR(10) NOT NULL DEFAULT 'unisex';
+		ALTER TABLE names_miracle ADD COLUMN IF NOT EXISTS syllables INTEGER NOT NULL DEFAULT 0;
+		ALTER TABLE names_miracle ADD COLUMN IF NOT EXISTS first_letter VARCHAR(4) NOT NULL DEFAULT '';
+		ALTER TABLE names_miracle ADD COLUMN IF NOT EXISTS category_strengths JSONB NOT NULL DEFAULT '{}';
+		CREATE INDEX IF NOT EXISTS idx_names_miracle_gender ON names_miracle(gender);
+		CREATE INDEX IF NOT EXISTS idx_names_miracle_syllables ON names_miracle(syllables);
+		CREATE INDEX IF NOT EXISTS idx_names_miracle_first_letter ON names_miracle(first_letter);
+	`
+	if _, err := db.Exec(migrationSearchMetadataSQL); err != nil {
+		log.Printf("Migration Warning (Search Metadata): %v", err)
+	}
+
+	// Auto-migrate Name Dictionary
+	migrationNameDictionarySQL := `
+		CREATE TABLE IF NOT EXISTS name_dictionary (
+			id SERIAL PRIMARY KEY,
+			term VARCHAR(100) NOT NULL,
+			kind VARCHAR(20) NOT NULL,
+			origin VARCHAR(50) NOT NULL DEFAULT '',
+			meaning TEXT NOT NULL,
+			source VARCHAR(20) NOT NULL DEFAULT 'curated',
+			status VARCHAR(20) NOT NULL DEFAULT 'approved',
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE (term, kind)
+		);
+		CREATE INDEX IF NOT EXISTS idx_name_dictionary_status ON name_dictionary(status);
+		DO $$
+		BEGIN
+			IF to_regclass('linguistic_cache') IS NOT NULL THEN
+				INSERT INTO name_dictionary (term, kind, meaning, source, status)
+				SELECT name_text, 'name', analysis_markdown, 'llm', 'pending' FROM linguistic_cache
+				ON CONFLICT (term, kind) DO NOTHING;
+			END IF;
+		END $$;
+	`
+	if _, err := db.Exec(migrationNameDictionarySQL); err != nil {
+		log.Printf("Migration Warning (Name Dictionary): %v", err)
+	}
+
+	// Auto-migrate Reference Data Notify
+	migrationReferenceNotifySQL := `
+		CREATE OR REPLACE FUNCTION notify_reference_data_changed() RETURNS trigger AS $$
+		BEGIN
+			PERFORM pg_notify('reference_data_changed', TG_TABLE_NAME);
+			RETURN NULL;
+		END;
+		$$ LANGUAGE plpgsql;
+
+		DO $$
+		DECLARE
+			t TEXT;
+		BEGIN
+			FOREACH t IN ARRAY ARRAY[
+				'sat_nums', 'sha_nums', 'latin_sat_nums', 'latin_sha_nums', 'kakis_day', 'taksa_day',
+				'numbers', 'number_categories', 'scoring_rulesets', 'name_dictionary', 'sample_names'
+			] LOOP
+				IF to_regclass(t) IS NOT NULL THEN
+					EXECUTE format('DROP TRIGGER IF EXISTS %I ON %I', t || '_reference_data_changed', t);
+					EXECUTE format(
+						'CREATE TRIGGER %I AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON %I
+						 FOR EACH STATEMENT EXECUTE FUNCTION notify_reference_data_changed()',
+						t || '_reference_data_changed', t);
+				END IF;
+			END LOOP;
+		END $$;
+	`
+	if _, err := db.Exec(migrationReferenceNotifySQL); err != nil {
+		log.Printf("Migration Warning (Reference Data Notify): %v", err)
+	}
+
+	// Auto-migrate Name Dictionary Notify: LLM inserts do not reload other instances
+	migrationNameDictionaryNotifySQL := `
+		DO $$
+		BEGIN
+			IF to_regclass('name_dictionary') IS NOT NULL THEN
+				DROP TRIGGER IF EXISTS name_dictionary_reference_data_changed ON name_dictionary;
+				DROP TRIGGER IF EXISTS name_dictionary_curated_insert ON name_dictionary;
+				CREATE TRIGGER name_dictionary_reference_data_changed
+					AFTER UPDATE OR DELETE OR TRUNCATE ON name_dictionary
+					FOR EACH STATEMENT EXECUTE FUNCTION notify_reference_data_changed();
+				CREATE TRIGGER name_dictionary_curated_insert
+					AFTER INSERT ON name_dictionary
+					FOR EACH ROW WHEN (NEW.source <> 'llm') EXECUTE FUNCTION notify_reference_data_changed();
+			END IF;
+		END $$;
+	`
+	if _, err := db.Exec(migrationNameDictionaryNotifySQL); err != nil {
+		log.Printf("Migration Warning (Name Dictionary Notify): %v", err)
+	}
+
+	// Auto-migrate Reference Data Changes
+	migrationReferenceChangesSQL := `
+		CREATE TABLE IF NOT EXISTS reference_data_changes (
+			id SERIAL PRIMARY KEY,
+			table_name VARCHAR(50) NOT NULL,
+			record_key VARCHAR(100) NOT NULL,
+			action VARCHAR(10) NOT NULL,
+			before_data JSONB,
+			after_data JSONB,
+			changed_by INTEGER,
+			changed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+		CREATE INDEX IF NOT EXISTS idx_reference_data_changes_record ON reference_data_changes(table_name, record_key, changed_at DESC);
+	`
+	if _, err := db.Exec(migrationReferenceChangesSQL); err != nil {
+		log.Printf("Migration Warning (Reference Data Changes): %v", err)
+	}
+
 	// Fix Foreign Key Constraints for User Deletion
 	migrationFKFixSQL := `
 		-- Ensure fcm_tokens exists and has cascade
@@ -1014,36 +1538,3 @@ func setupDatabase() *sql.DB {
 	fmt.Println("Successfully connected to database and migrated schema!")
 	return db
 }
-
-func setupNumerologyCache(db *sql.DB, tableName string) *cache.NumerologyCache {
-	repo := repository.NewPostgresNumerologyRepository(db, tableName)
-	c := cache.NewNumerologyCache(repo)
-	c.GetAll()
-	fmt.Printf("Cache for table '%s' is ready.\n", tableName)
-	return c
-}
-
-func setupKlakiniCache(db *sql.DB) *cache.KlakiniCache {
-	repo := repository.NewPostgresKlakiniRepository(db)
-	c := cache.NewKlakiniCache(repo)
-	c.EnsureLoaded()
-	fmt.Println("Klakini cache is ready.")
-	return c
-}
-
-func setupNumberPairCache(db *sql.DB) *cache.NumberPairCache {
-	repo := repository.NewPostgresNumberPairRepository(db)
-	c := cache.NewNumberPairCache(repo)
-	c.EnsureLoaded()
-	fmt.Println("Number pair meaning cache is ready.")
-	return c
-}
-func setupNumberCategoryCache(db *sql.DB) *cache.NumberCategoryCache {
-	log.Println("Setting up NumberCategoryCache...")
-	repo := repository.NewPostgresNumberCategoryRepository(db)
-	c := cache.NewNumberCategoryCache(repo)
-	c.EnsureLoaded()
-	fmt.Println("Number category cache is ready.")
-	log.Println("NumberCategoryCache setup complete.")
-	return c
-}
@@ -0,0 +1 @@
+ALTER TABLE saved_names DROP COLUMN IF EXISTS surname;
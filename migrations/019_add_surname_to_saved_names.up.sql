@@ -0,0 +1 @@
+ALTER TABLE saved_names ADD COLUMN IF NOT EXISTS surname VARCHAR(255) NOT NULL DEFAULT '';
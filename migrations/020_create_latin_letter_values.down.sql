@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS latin_sha_nums;
+DROP TABLE IF EXISTS latin_sat_nums;
@@ -0,0 +1,2 @@
+ALTER TABLE saved_names DROP COLUMN IF EXISTS ruleset_version;
+DROP TABLE IF EXISTS scoring_rulesets;
@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS scoring_rulesets (
+    id SERIAL PRIMARY KEY,
+    version INTEGER NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    rules JSONB NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    activated_at TIMESTAMP
+);
+
+INSERT INTO scoring_rulesets (version, name, rules, is_active, activated_at) VALUES (
+    1,
+    'Default',
+    '{
+        "tiers": [
+            {"pair_type": "D10", "class": "good", "color": "#2E7D32"},
+            {"pair_type": "D8", "class": "good", "color": "#43A047"},
+            {"pair_type": "D5", "class": "good", "color": "#66BB6A"},
+            {"pair_type": "R10", "class": "bad", "color": "#C62828"},
+            {"pair_type": "R7", "class": "bad", "color": "#E53935"},
+            {"pair_type": "R5", "class": "bad", "color": "#EF5350"}
+        ],
+        "bad_pair_color": "#D32F2F",
+        "unknown_pair_color": "#9E9E9E",
+        "top_tier_types": ["D10", "D8", "D5"],
+        "penalties": {"bad_pair": 0, "klakini": 0}
+    }',
+    true,
+    CURRENT_TIMESTAMP
+) ON CONFLICT (version) DO NOTHING;
+
+ALTER TABLE saved_names ADD COLUMN IF NOT EXISTS ruleset_version INTEGER NOT NULL DEFAULT 1;
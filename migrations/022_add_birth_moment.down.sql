@@ -0,0 +1,7 @@
+ALTER TABLE saved_names DROP COLUMN IF EXISTS birth_day_boundary;
+ALTER TABLE saved_names DROP COLUMN IF EXISTS birth_time;
+ALTER TABLE saved_names DROP COLUMN IF EXISTS birth_date;
+
+ALTER TABLE member DROP COLUMN IF EXISTS birth_day_boundary;
+ALTER TABLE member DROP COLUMN IF EXISTS birth_time;
+ALTER TABLE member DROP COLUMN IF EXISTS birth_date;
@@ -0,0 +1,7 @@
+ALTER TABLE member ADD COLUMN IF NOT EXISTS birth_date DATE;
+ALTER TABLE member ADD COLUMN IF NOT EXISTS birth_time TIME;
+ALTER TABLE member ADD COLUMN IF NOT EXISTS birth_day_boundary VARCHAR(10);
+
+ALTER TABLE saved_names ADD COLUMN IF NOT EXISTS birth_date DATE;
+ALTER TABLE saved_names ADD COLUMN IF NOT EXISTS birth_time TIME;
+ALTER TABLE saved_names ADD COLUMN IF NOT EXISTS birth_day_boundary VARCHAR(10);
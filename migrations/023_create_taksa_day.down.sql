@@ -0,0 +1 @@
+DROP TABLE IF EXISTS taksa_day;
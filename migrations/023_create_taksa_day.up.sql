@@ -0,0 +1,76 @@
+-- Taksa (ทักษา) letter groups per birth day. Counting starts at the birth day's
+-- planet (บริวาร) and goes round the wheel: Sunday, Monday, Tuesday, Wednesday,
+-- Saturday, Thursday, Wednesday night (Rahu), Friday.
+CREATE TABLE IF NOT EXISTS taksa_day (
+    day VARCHAR(20) NOT NULL,
+    taksa_group VARCHAR(20) NOT NULL,
+    chars TEXT NOT NULL,
+    PRIMARY KEY (day, taksa_group)
+);
+
+INSERT INTO taksa_day (day, taksa_group, chars) VALUES
+    ('sunday', 'บริวาร', 'อะัาำิีึืุูเแโใไ'),
+    ('sunday', 'อายุ', 'กขฃคฅฆง'),
+    ('sunday', 'เดช', 'จฉชซฌญ'),
+    ('sunday', 'ศรี', 'ฎฏฐฑฒณ'),
+    ('sunday', 'มูละ', 'ดตถทธน'),
+    ('sunday', 'อุตสาหะ', 'บปผฝพฟภม'),
+    ('sunday', 'มนตรี', 'ยรลว'),
+    ('sunday', 'กาลกิณี', 'ศษสหฬฮ'),
+    ('monday', 'บริวาร', 'กขฃคฅฆง'),
+    ('monday', 'อายุ', 'จฉชซฌญ'),
+    ('monday', 'เดช', 'ฎฏฐฑฒณ'),
+    ('monday', 'ศรี', 'ดตถทธน'),
+    ('monday', 'มูละ', 'บปผฝพฟภม'),
+    ('monday', 'อุตสาหะ', 'ยรลว'),
+    ('monday', 'มนตรี', 'ศษสหฬฮ'),
+    ('monday', 'กาลกิณี', 'อะัาำิีึืุูเแโใไ'),
+    ('tuesday', 'บริวาร', 'จฉชซฌญ'),
+    ('tuesday', 'อายุ', 'ฎฏฐฑฒณ'),
+    ('tuesday', 'เดช', 'ดตถทธน'),
+    ('tuesday', 'ศรี', 'บปผฝพฟภม'),
+    ('tuesday', 'มูละ', 'ยรลว'),
+    ('tuesday', 'อุตสาหะ', 'ศษสหฬฮ'),
+    ('tuesday', 'มนตรี', 'อะัาำิีึืุูเแโใไ'),
+    ('tuesday', 'กาลกิณี', 'กขฃคฅฆง'),
+    ('wednesday1', 'บริวาร', 'ฎฏฐฑฒณ'),
+    ('wednesday1', 'อายุ', 'ดตถทธน'),
+    ('wednesday1', 'เดช', 'บปผฝพฟภม'),
+    ('wednesday1', 'ศรี', 'ยรลว'),
+    ('wednesday1', 'มูละ', 'ศษสหฬฮ'),
+    ('wednesday1', 'อุตสาหะ', 'อะัาำิีึืุูเแโใไ'),
+    ('wednesday1', 'มนตรี', 'กขฃคฅฆง'),
+    ('wednesday1', 'กาลกิณี', 'จฉชซฌญ'),
+    ('saturday', 'บริวาร', 'ดตถทธน'),
+    ('saturday', 'อายุ', 'บปผฝพฟภม'),
+    ('saturday', 'เดช', 'ยรลว'),
+    ('saturday', 'ศรี', 'ศษสหฬฮ'),
+    ('saturday', 'มูละ', 'อะัาำิีึืุูเแโใไ'),
+    ('saturday', 'อุตสาหะ', 'กขฃคฅฆง'),
+    ('saturday', 'มนตรี', 'จฉชซฌญ'),
+    ('saturday', 'กาลกิณี', 'ฎฏฐฑฒณ'),
+    ('thursday', 'บริวาร', 'บปผฝพฟภม'),
+    ('thursday', 'อายุ', 'ยรลว'),
+    ('thursday', 'เดช', 'ศษสหฬฮ'),
+    ('thursday', 'ศรี', 'อะัาำิีึืุูเแโใไ'),
+    ('thursday', 'มูละ', 'กขฃคฅฆง'),
+    ('thursday', 'อุตสาหะ', 'จฉชซฌญ'),
+    ('thursday', 'มนตรี', 'ฎฏฐฑฒณ'),
+    ('thursday', 'กาลกิณี', 'ดตถทธน'),
+    ('wednesday2', 'บริวาร', 'ยรลว'),
+    ('wednesday2', 'อายุ', 'ศษสหฬฮ'),
+    ('wednesday2', 'เดช', 'อะัาำิีึืุูเแโใไ'),
+    ('wednesday2', 'ศรี', 'กขฃคฅฆง'),
+    ('wednesday2', 'มูละ', 'จฉชซฌญ'),
+    ('wednesday2', 'อุตสาหะ', 'ฎฏฐฑฒณ'),
+    ('wednesday2', 'มนตรี', 'ดตถทธน'),
+    ('wednesday2', 'กาลกิณี', 'บปผฝพฟภม'),
+    ('friday', 'บริวาร', 'ศษสหฬฮ'),
+    ('friday', 'อายุ', 'อะัาำิีึืุูเแโใไ'),
+    ('friday', 'เดช', 'กขฃคฅฆง'),
+    ('friday', 'ศรี', 'จฉชซฌญ'),
+    ('friday', 'มูละ', 'ฎฏฐฑฒณ'),
+    ('friday', 'อุตสาหะ', 'ดตถทธน'),
+    ('friday', 'มนตรี', 'บปผฝพฟภม'),
+    ('friday', 'กาลกิณี', 'ยรลว')
+ON CONFLICT (day, taksa_group) DO NOTHING;
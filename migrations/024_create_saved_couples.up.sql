@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS saved_couples (
+    id SERIAL PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP,
+    user_id INTEGER NOT NULL REFERENCES member(id) ON DELETE CASCADE,
+    name1 VARCHAR(255) NOT NULL,
+    surname1 VARCHAR(255) NOT NULL DEFAULT '',
+    day1 VARCHAR(20) NOT NULL,
+    name2 VARCHAR(255) NOT NULL,
+    surname2 VARCHAR(255) NOT NULL DEFAULT '',
+    day2 VARCHAR(20) NOT NULL,
+    score INTEGER NOT NULL DEFAULT 0,
+    ruleset_version INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE INDEX IF NOT EXISTS idx_saved_couples_user_id ON saved_couples(user_id);
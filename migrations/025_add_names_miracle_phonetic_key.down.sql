@@ -0,0 +1 @@
+ALTER TABLE names_miracle DROP COLUMN IF EXISTS phonetic_key;
@@ -0,0 +1,2 @@
+-- Phonetic key per name (see numerology.PhoneticKey), filled by the import and by cmd/recompute_names.
+ALTER TABLE names_miracle ADD COLUMN IF NOT EXISTS phonetic_key TEXT NOT NULL DEFAULT '';
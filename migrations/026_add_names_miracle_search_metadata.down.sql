@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_names_miracle_first_letter;
+DROP INDEX IF EXISTS idx_names_miracle_syllables;
+DROP INDEX IF EXISTS idx_names_miracle_gender;
+
+ALTER TABLE names_miracle DROP COLUMN IF EXISTS category_strengths;
+ALTER TABLE names_miracle DROP COLUMN IF EXISTS first_letter;
+ALTER TABLE names_miracle DROP COLUMN IF EXISTS syllables;
+ALTER TABLE names_miracle DROP COLUMN IF EXISTS gender;
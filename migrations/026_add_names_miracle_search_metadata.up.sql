@@ -0,0 +1,9 @@
+-- Search metadata per name, filled by the import and by cmd/recompute_names.
+ALTER TABLE names_miracle ADD COLUMN IF NOT EXISTS gender VARCHAR(10) NOT NULL DEFAULT 'unisex';
+ALTER TABLE names_miracle ADD COLUMN IF NOT EXISTS syllables INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE names_miracle ADD COLUMN IF NOT EXISTS first_letter VARCHAR(4) NOT NULL DEFAULT '';
+ALTER TABLE names_miracle ADD COLUMN IF NOT EXISTS category_strengths JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_names_miracle_gender ON names_miracle(gender);
+CREATE INDEX IF NOT EXISTS idx_names_miracle_syllables ON names_miracle(syllables);
+CREATE INDEX IF NOT EXISTS idx_names_miracle_first_letter ON names_miracle(first_letter);
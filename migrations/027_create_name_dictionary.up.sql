@@ -0,0 +1,54 @@
+-- Curated meanings of name parts (Pali/Sanskrit roots, syllables) and whole names.
+-- LLM answers are stored as pending entries for an admin to review.
+CREATE TABLE IF NOT EXISTS name_dictionary (
+    id SERIAL PRIMARY KEY,
+    term VARCHAR(100) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    origin VARCHAR(50) NOT NULL DEFAULT '',
+    meaning TEXT NOT NULL,
+    source VARCHAR(20) NOT NULL DEFAULT 'curated',
+    status VARCHAR(20) NOT NULL DEFAULT 'approved',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (term, kind)
+);
+
+CREATE INDEX IF NOT EXISTS idx_name_dictionary_status ON name_dictionary(status);
+
+-- Earlier LLM analyses become whole-name entries waiting for review.
+DO $$
+BEGIN
+    IF to_regclass('linguistic_cache') IS NOT NULL THEN
+        INSERT INTO name_dictionary (term, kind, meaning, source, status)
+        SELECT name_text, 'name', analysis_markdown, 'llm', 'pending' FROM linguistic_cache
+        ON CONFLICT (term, kind) DO NOTHING;
+    END IF;
+END $$;
+
+INSERT INTO name_dictionary (term, kind, origin, meaning) VALUES
+    ('กมล', 'root', 'บาลี', 'ดอกบัว, ใจ'),
+    ('กานต์', 'root', 'สันสกฤต', 'เป็นที่รัก'),
+    ('กิตติ', 'root', 'บาลี', 'เกียรติ, ชื่อเสียง'),
+    ('จันทร์', 'root', 'สันสกฤต', 'ดวงจันทร์'),
+    ('ชนก', 'root', 'บาลี', 'ผู้ให้กำเนิด, พ่อ'),
+    ('ชัย', 'root', 'บาลี', 'ความชนะ'),
+    ('ณัฐ', 'root', 'บาลี', 'นักปราชญ์'),
+    ('ธน', 'root', 'บาลี', 'ทรัพย์'),
+    ('ธิดา', 'root', 'บาลี', 'ลูกสาว'),
+    ('ธีร', 'root', 'บาลี', 'นักปราชญ์, ผู้มั่นคง'),
+    ('นภา', 'root', 'บาลี', 'ท้องฟ้า'),
+    ('ปัญญา', 'root', 'บาลี', 'ความรอบรู้'),
+    ('พงศ์', 'root', 'สันสกฤต', 'เชื้อสาย, วงศ์ตระกูล'),
+    ('พร', 'root', 'บาลี', 'สิ่งประเสริฐ, คำอวยพร'),
+    ('ภัทร', 'root', 'สันสกฤต', 'ความดีงาม, ความเจริญ'),
+    ('ภูมิ', 'root', 'บาลี', 'แผ่นดิน'),
+    ('มณี', 'root', 'บาลี', 'แก้ว, อัญมณี'),
+    ('รัตน์', 'root', 'สันสกฤต', 'แก้ว, สิ่งมีค่า'),
+    ('วร', 'root', 'บาลี', 'ประเสริฐ'),
+    ('วุฒิ', 'root', 'บาลี', 'ความเจริญ'),
+    ('ศรี', 'root', 'สันสกฤต', 'มิ่งขวัญ, ความงาม'),
+    ('ศักดิ์', 'root', 'สันสกฤต', 'อำนาจ, ความสามารถ'),
+    ('สุ', 'syllable', 'บาลี', 'ดี, งาม (คำนำหน้า)'),
+    ('อร', 'root', 'บาลี', 'หญิงงาม'),
+    ('ฤดี', 'root', 'สันสกฤต', 'ความยินดี')
+ON CONFLICT (term, kind) DO NOTHING;
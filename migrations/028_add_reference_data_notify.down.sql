@@ -0,0 +1,15 @@
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY[
+        'sat_nums', 'sha_nums', 'latin_sat_nums', 'latin_sha_nums', 'kakis_day', 'taksa_day',
+        'numbers', 'number_categories', 'scoring_rulesets', 'name_dictionary', 'sample_names'
+    ] LOOP
+        IF to_regclass(t) IS NOT NULL THEN
+            EXECUTE format('DROP TRIGGER IF EXISTS %I ON %I', t || '_reference_data_changed', t);
+        END IF;
+    END LOOP;
+END $$;
+
+DROP FUNCTION IF EXISTS notify_reference_data_changed();
@@ -0,0 +1,26 @@
+-- Publish every change to reference data on the reference_data_changed channel,
+-- with the table name as payload, so each app instance reloads its caches.
+CREATE OR REPLACE FUNCTION notify_reference_data_changed() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('reference_data_changed', TG_TABLE_NAME);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY[
+        'sat_nums', 'sha_nums', 'latin_sat_nums', 'latin_sha_nums', 'kakis_day', 'taksa_day',
+        'numbers', 'number_categories', 'scoring_rulesets', 'name_dictionary', 'sample_names'
+    ] LOOP
+        IF to_regclass(t) IS NOT NULL THEN
+            EXECUTE format('DROP TRIGGER IF EXISTS %I ON %I', t || '_reference_data_changed', t);
+            EXECUTE format(
+                'CREATE TRIGGER %I AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON %I
+                 FOR EACH STATEMENT EXECUTE FUNCTION notify_reference_data_changed()',
+                t || '_reference_data_changed', t);
+        END IF;
+    END LOOP;
+END $$;
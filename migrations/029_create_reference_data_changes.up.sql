@@ -0,0 +1,14 @@
+-- History of admin edits to reference tables (numbers, number_categories, ...).
+-- before_data is NULL for creates and after_data for deletes.
+CREATE TABLE IF NOT EXISTS reference_data_changes (
+    id SERIAL PRIMARY KEY,
+    table_name VARCHAR(50) NOT NULL,
+    record_key VARCHAR(100) NOT NULL,
+    action VARCHAR(10) NOT NULL,
+    before_data JSONB,
+    after_data JSONB,
+    changed_by INTEGER,
+    changed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_reference_data_changes_record ON reference_data_changes(table_name, record_key, changed_at DESC);
@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS name_dictionary_curated_insert ON name_dictionary;
+DROP TRIGGER IF EXISTS name_dictionary_reference_data_changed ON name_dictionary;
+
+CREATE TRIGGER name_dictionary_reference_data_changed
+    AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON name_dictionary
+    FOR EACH STATEMENT EXECUTE FUNCTION notify_reference_data_changed();
@@ -0,0 +1,13 @@
+-- LLM answers are inserted into name_dictionary one unknown name at a time, and
+-- the instance that asked adds them to its own cache. Only curated inserts and
+-- every update or delete tell the other instances to reload the dictionary.
+DROP TRIGGER IF EXISTS name_dictionary_reference_data_changed ON name_dictionary;
+DROP TRIGGER IF EXISTS name_dictionary_curated_insert ON name_dictionary;
+
+CREATE TRIGGER name_dictionary_reference_data_changed
+    AFTER UPDATE OR DELETE OR TRUNCATE ON name_dictionary
+    FOR EACH STATEMENT EXECUTE FUNCTION notify_reference_data_changed();
+
+CREATE TRIGGER name_dictionary_curated_insert
+    AFTER INSERT ON name_dictionary
+    FOR EACH ROW WHEN (NEW.source <> 'llm') EXECUTE FUNCTION notify_reference_data_changed();
@@ -0,0 +1,2 @@
+-- Nothing to undo: cmd/recompute_names fills the cleared columns again.
+SELECT 1;
@@ -0,0 +1,7 @@
+-- PhoneticKey used to read a word-final consonant+ร (ภัทร, จักร) and the ทร of ทร์
+-- (จันทร์) as an extra syllable. Clear the keys of the names spelled that way; the
+-- names_miracle index computes them again on load, and cmd/recompute_names writes
+-- the corrected phonetic_key and syllables back.
+UPDATE names_miracle
+SET phonetic_key = '', syllables = 0
+WHERE thname ~ '[ก-ฮ]ร( |$)' OR thname LIKE '%ร์%';
@@ -21,6 +21,7 @@ type IndexProps struct {
 	DefaultDay            string
 	SampleNames           []domain.SampleName
 	SolarSystem           SolarSystemProps
+	FullName              *domain.FullNameAnalysis // Set when a surname is given
 	IsVIP                 bool
 	Results               templ.Component
 	SolarSystemInitial    templ.Component // Added for lazy loading
@@ -66,7 +67,7 @@ func Index(props IndexProps) templ.Component {
 			var templ_7745c5c3_Var3 string
 			templ_7745c5c3_Var3, templ_7745c5c3_Err = templ.JoinStringErrs(props.DefaultName)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `views/analysis/index.templ`, Line: 47, Col: 124}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `views/analysis/index.templ`, Line: 48, Col: 124}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var3))
 			if templ_7745c5c3_Err != nil {
@@ -182,7 +183,7 @@ func Index(props IndexProps) templ.Component {
 				var templ_7745c5c3_Var6 string
 				templ_7745c5c3_Var6, templ_7745c5c3_Err = templ.JoinStringErrs(strings.TrimSpace(sample.Name))
 				if templ_7745c5c3_Err != nil {
-					return templ.Error{Err: templ_7745c5c3_Err, FileName: `views/analysis/index.templ`, Line: 90, Col: 48}
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `views/analysis/index.templ`, Line: 91, Col: 48}
 				}
 				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var6))
 				if templ_7745c5c3_Err != nil {
@@ -203,7 +204,7 @@ func Index(props IndexProps) templ.Component {
 				var templ_7745c5c3_Var7 string
 				templ_7745c5c3_Var7, templ_7745c5c3_Err = templ.JoinStringErrs(sample.AvatarURL)
 				if templ_7745c5c3_Err != nil {
-					return templ.Error{Err: templ_7745c5c3_Err, FileName: `views/analysis/index.templ`, Line: 93, Col: 33}
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `views/analysis/index.templ`, Line: 94, Col: 33}
 				}
 				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var7))
 				if templ_7745c5c3_Err != nil {
@@ -216,7 +217,7 @@ func Index(props IndexProps) templ.Component {
 				var templ_7745c5c3_Var8 string
 				templ_7745c5c3_Var8, templ_7745c5c3_Err = templ.JoinStringErrs(sample.Name)
 				if templ_7745c5c3_Err != nil {
-					return templ.Error{Err: templ_7745c5c3_Err, FileName: `views/analysis/index.templ`, Line: 94, Col: 144}
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `views/analysis/index.templ`, Line: 95, Col: 144}
 				}
 				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var8))
 				if templ_7745c5c3_Err != nil {
@@ -242,7 +243,17 @@ func Index(props IndexProps) templ.Component {
 					return templ_7745c5c3_Err
 				}
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 26, "</div></div><div id=\"results-wrapper\" class=\"htmx-indicator-container\"><!-- Table Loading Indicator (Skeleton) --><div id=\"table-loading\" class=\"htmx-indicator\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 26, "</div></div>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			if props.FullName != nil {
+				templ_7745c5c3_Err = FullNamePillars(props.FullName).Render(ctx, templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 27, "<div id=\"results-wrapper\" class=\"htmx-indicator-container\"><!-- Table Loading Indicator (Skeleton) --><div id=\"table-loading\" class=\"htmx-indicator\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
@@ -254,7 +265,7 @@ func Index(props IndexProps) templ.Component {
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 27, "</div><div id=\"results\"><!-- This is where the streaming data will be injected -->")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 28, "</div><div id=\"results\"><!-- This is where the streaming data will be injected -->")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
@@ -262,7 +273,7 @@ func Index(props IndexProps) templ.Component {
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 28, "</div><!-- Call to Action Section --><div style=\"margin-top: 4rem; margin-bottom: 2rem;\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 29, "</div><!-- Call to Action Section --><div style=\"margin-top: 4rem; margin-bottom: 2rem;\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
@@ -270,7 +281,7 @@ func Index(props IndexProps) templ.Component {
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 29, "</div></div></div><script>\n            (function() {\n                // Use IIFE to avoid global scope pollution\n                function initAvatarScroll() {\n                    const grid = document.getElementById('avatars-grid');\n                    if (!grid) {\n                        console.log(\"Avatar grid not found, retrying...\");\n                        setTimeout(initAvatarScroll, 500); // Retry if not found immediately\n                        return;\n                    }\n\n                    // Avoid double initialization\n                    if (grid.dataset.scrollInit === \"true\") return;\n                    grid.dataset.scrollInit = \"true\";\n\n                    // Clone children for infinite scroll\n                    const originalChildren = Array.from(grid.children);\n                    \n                    // Clone enough times to cover screen width + buffer\n                    // Simple approach: Triple the content\n                    originalChildren.forEach(child => {\n                        grid.appendChild(child.cloneNode(true));\n                    });\n                    originalChildren.forEach(child => {\n                        grid.appendChild(child.cloneNode(true));\n                    });\n\n                    let isPaused = false;\n                    let isDragging = false;\n                    let startX, scrollLeft;\n                    const speed = 0.5; // pixel per frame\n                    \n                    // Calculate the width of one set of items (approximate)\n                    // We can't trust scrollWidth immediately if images aren't loaded, \n                    // but usually avatar images are small.\n                    // Better logic: reset when scrollLeft >= scrollWidth / 3\n                    \n                    function autoScroll() {\n                        if (!isPaused && !isDragging) {\n                            grid.scrollLeft += speed;\n                            \n                            // Check reset condition\n                            // We have 3 sets. If we scroll past the first set (1/3 total), snap back to 0.\n                            // However, precise pixel math is better.\n                            // Let's rely on scrollWidth.\n                            if (grid.scrollLeft >= (grid.scrollWidth / 3)) {\n                                grid.scrollLeft = 0; // or subtract, but 0 is safer for drift\n                            }\n                        }\n                        requestAnimationFrame(autoScroll);\n                    }\n                    \n                    // Mouse Drag Logic\n                    grid.addEventListener('mousedown', (e) => {\n                        isDragging = true;\n                        isPaused = true;\n                        grid.style.cursor = 'grabbing';\n                        startX = e.pageX - grid.offsetLeft;\n                        scrollLeft = grid.scrollLeft;\n                    });\n                    \n                   grid.addEventListener('mouseleave', () => {\n                        isDragging = false;\n                        isPaused = false;\n                        grid.style.cursor = 'grab';\n                    });\n                    \n                    grid.addEventListener('mouseup', () => {\n                        isDragging = false;\n                        isPaused = false;\n                        grid.style.cursor = 'grab';\n                    });\n                    \n                    grid.addEventListener('mousemove', (e) => {\n                        if (!isDragging) return;\n                        e.preventDefault();\n                        const x = e.pageX - grid.offsetLeft;\n                        const walk = (x - startX) * 2; // scroll-fast\n                        grid.scrollLeft = scrollLeft - walk;\n                    });\n\n                    // Touch Logic\n                    grid.addEventListener('touchstart', () => { isPaused = true; });\n                    grid.addEventListener('touchend', () => { setTimeout(() => isPaused = false, 1000); });\n                    \n                    // Pause on Hover (optional, if user just wants to read)\n                    grid.addEventListener('mouseenter', () => isPaused = true);\n\n                    // Start loop\n                    autoScroll();\n                }\n\n                if (document.readyState === 'loading') {\n                    document.addEventListener('DOMContentLoaded', initAvatarScroll);\n                } else {\n                    initAvatarScroll();\n                }\n            })();\n        </script> <!-- Back to Top Button --> <button id=\"back-to-top\" onclick=\"scrollToTop()\" title=\"Go to top\"><svg xmlns=\"http://www.w3.org/2000/svg\" width=\"24\" height=\"24\" viewBox=\"0 0 24 24\" fill=\"none\" stroke=\"currentColor\" stroke-width=\"2\" stroke-linecap=\"round\" stroke-linejoin=\"round\"><path d=\"M18 15l-6-6-6 6\"></path></svg></button><!-- JavaScript --> <script>\n\t\t\tfunction selectName(name) {\n\t\t\t\tconst nameInput = document.getElementById('name');\n\t\t\t\tnameInput.value = name;\n\t\t\t\ttoggleClearButton();\n\t\t\t\tupdateAvatarActive(name);\n\t\t\n\t\t\t\t// Manually trigger the input event on the input field itself\n\t\t\t\thtmx.trigger('#name', 'input');\n\t\t\n\t\t\t\t// Scroll to the top of the form for better UX\n\t\t\t\tconst form = document.getElementById('name-form');\n\t\t\t\tif (form) {\n\t\t\t\t\tform.scrollIntoView({ behavior: 'smooth', block: 'start' });\n\t\t\t\t}\n\t\t\t}\n\n\t\t\tfunction updateAvatarActive(currentName) {\n\t\t\t\tconst avatars = document.querySelectorAll('.avatar-premium');\n\t\t\t\t// Normalize: remove whitespace\n\t\t\t\tconst clean = (str) => str ? str.trim().replace(/\\s+/g, '') : '';\n\t\t\t\tconst target = clean(currentName);\n\t\t\t\t\n\t\t\t\tavatars.forEach(avatar => {\n\t\t\t\t\tconst dataName = clean(avatar.getAttribute('data-name'));\n\t\t\t\t\t\n\t\t\t\t\t// Compare normalized values\n\t\t\t\t\tif (dataName === target && dataName.length > 0) {\n\t\t\t\t\t\tavatar.classList.add('active');\n\t\t\t\t\t} else {\n\t\t\t\t\t\tavatar.classList.remove('active');\n\t\t\t\t\t}\n\t\t\t\t});\n\t\t\t}\n\n\t\t\t// Update active avatar and clear button state on load\n\t\t\tdocument.addEventListener('DOMContentLoaded', function() {\n\t\t\t\tconst nameInput = document.getElementById('name');\n\t\t\t\tif (nameInput) {\n\t\t\t\t\ttoggleClearButton();\n\t\t\t\t\tupdateAvatarActive(nameInput.value);\n\t\t\t\t\tnameInput.addEventListener('input', function() {\n\t\t\t\t\t\tupdateAvatarActive(this.value);\n\t\t\t\t\t});\n\t\t\t\t}\n\t\t\t});\n\t\t\n\t\t\tfunction toggleClearButton() {\n\t\t\t\tconst nameInput = document.getElementById('name');\n\t\t\t\tconst clearBtn = document.getElementById('clear-btn');\n\t\t\t\tif (nameInput && nameInput.value.length > 0) {\n\t\t\t\t\tclearBtn.style.display = 'block';\n\t\t\t\t} else if (clearBtn) {\n\t\t\t\t\tclearBtn.style.display = 'none';\n\t\t\t\t}\n\t\t\t}\n\t\t\n\t\t\tfunction clearName() {\n\t\t\t\tconst nameInput = document.getElementById('name');\n\t\t\t\tnameInput.value = '';\n\t\t\t\ttoggleClearButton();\n\t\t\t\tnameInput.focus();\n\t\t\t\t// Manually trigger the input event to clear the results via HTMX\n\t\t\t\thtmx.trigger('#name', 'input');\n\t\t\t}\n\t\t\n\t\t\t// Back to Top functionality\n\t\t\tconst backToTopButton = document.getElementById(\"back-to-top\");\n\t\t\n\t\t\twindow.onscroll = function () {\n\t\t\t\tif (document.body.scrollTop > 200 || document.documentElement.scrollTop > 200) {\n\t\t\t\t\tbackToTopButton.style.display = \"flex\";\n\t\t\t\t} else {\n\t\t\t\t\tbackToTopButton.style.display = \"none\";\n\t\t\t\t}\n\t\t\t};\n\t\t\n\t\t\tfunction scrollToTop() {\n\t\t\t\twindow.scrollTo({ top: 0, behavior: 'smooth' });\n\t\t\t}\n\t\t\n\t\t\tdocument.addEventListener('DOMContentLoaded', function () {\n\t\t\t\ttoggleClearButton();\n\t\t\t\t// Trigger initial load from the input field\n\t\t\t\t// htmx.trigger('#name', 'load'); // REMOVED: Managed by streaming\n\t\t\t});\n\t\t</script>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 30, "</div></div></div><script>\n            (function() {\n                // Use IIFE to avoid global scope pollution\n                function initAvatarScroll() {\n                    const grid = document.getElementById('avatars-grid');\n                    if (!grid) {\n                        console.log(\"Avatar grid not found, retrying...\");\n                        setTimeout(initAvatarScroll, 500); // Retry if not found immediately\n                        return;\n                    }\n\n                    // Avoid double initialization\n                    if (grid.dataset.scrollInit === \"true\") return;\n                    grid.dataset.scrollInit = \"true\";\n\n                    // Clone children for infinite scroll\n                    const originalChildren = Array.from(grid.children);\n                    \n                    // Clone enough times to cover screen width + buffer\n                    // Simple approach: Triple the content\n                    originalChildren.forEach(child => {\n                        grid.appendChild(child.cloneNode(true));\n                    });\n                    originalChildren.forEach(child => {\n                        grid.appendChild(child.cloneNode(true));\n                    });\n\n                    let isPaused = false;\n                    let isDragging = false;\n                    let startX, scrollLeft;\n                    const speed = 0.5; // pixel per frame\n                    \n                    // Calculate the width of one set of items (approximate)\n                    // We can't trust scrollWidth immediately if images aren't loaded, \n                    // but usually avatar images are small.\n                    // Better logic: reset when scrollLeft >= scrollWidth / 3\n                    \n                    function autoScroll() {\n                        if (!isPaused && !isDragging) {\n                            grid.scrollLeft += speed;\n                            \n                            // Check reset condition\n                            // We have 3 sets. If we scroll past the first set (1/3 total), snap back to 0.\n                            // However, precise pixel math is better.\n                            // Let's rely on scrollWidth.\n                            if (grid.scrollLeft >= (grid.scrollWidth / 3)) {\n                                grid.scrollLeft = 0; // or subtract, but 0 is safer for drift\n                            }\n                        }\n                        requestAnimationFrame(autoScroll);\n                    }\n                    \n                    // Mouse Drag Logic\n                    grid.addEventListener('mousedown', (e) => {\n                        isDragging = true;\n                        isPaused = true;\n                        grid.style.cursor = 'grabbing';\n                        startX = e.pageX - grid.offsetLeft;\n                        scrollLeft = grid.scrollLeft;\n                    });\n                    \n                   grid.addEventListener('mouseleave', () => {\n                        isDragging = false;\n                        isPaused = false;\n                        grid.style.cursor = 'grab';\n                    });\n                    \n                    grid.addEventListener('mouseup', () => {\n                        isDragging = false;\n                        isPaused = false;\n                        grid.style.cursor = 'grab';\n                    });\n                    \n                    grid.addEventListener('mousemove', (e) => {\n                        if (!isDragging) return;\n                        e.preventDefault();\n                        const x = e.pageX - grid.offsetLeft;\n                        const walk = (x - startX) * 2; // scroll-fast\n                        grid.scrollLeft = scrollLeft - walk;\n                    });\n\n                    // Touch Logic\n                    grid.addEventListener('touchstart', () => { isPaused = true; });\n                    grid.addEventListener('touchend', () => { setTimeout(() => isPaused = false, 1000); });\n                    \n                    // Pause on Hover (optional, if user just wants to read)\n                    grid.addEventListener('mouseenter', () => isPaused = true);\n\n                    // Start loop\n                    autoScroll();\n                }\n\n                if (document.readyState === 'loading') {\n                    document.addEventListener('DOMContentLoaded', initAvatarScroll);\n                } else {\n                    initAvatarScroll();\n                }\n            })();\n        </script> <!-- Back to Top Button --> <button id=\"back-to-top\" onclick=\"scrollToTop()\" title=\"Go to top\"><svg xmlns=\"http://www.w3.org/2000/svg\" width=\"24\" height=\"24\" viewBox=\"0 0 24 24\" fill=\"none\" stroke=\"currentColor\" stroke-width=\"2\" stroke-linecap=\"round\" stroke-linejoin=\"round\"><path d=\"M18 15l-6-6-6 6\"></path></svg></button><!-- JavaScript --> <script>\n\t\t\tfunction selectName(name) {\n\t\t\t\tconst nameInput = document.getElementById('name');\n\t\t\t\tnameInput.value = name;\n\t\t\t\ttoggleClearButton();\n\t\t\t\tupdateAvatarActive(name);\n\t\t\n\t\t\t\t// Manually trigger the input event on the input field itself\n\t\t\t\thtmx.trigger('#name', 'input');\n\t\t\n\t\t\t\t// Scroll to the top of the form for better UX\n\t\t\t\tconst form = document.getElementById('name-form');\n\t\t\t\tif (form) {\n\t\t\t\t\tform.scrollIntoView({ behavior: 'smooth', block: 'start' });\n\t\t\t\t}\n\t\t\t}\n\n\t\t\tfunction updateAvatarActive(currentName) {\n\t\t\t\tconst avatars = document.querySelectorAll('.avatar-premium');\n\t\t\t\t// Normalize: remove whitespace\n\t\t\t\tconst clean = (str) => str ? str.trim().replace(/\\s+/g, '') : '';\n\t\t\t\tconst target = clean(currentName);\n\t\t\t\t\n\t\t\t\tavatars.forEach(avatar => {\n\t\t\t\t\tconst dataName = clean(avatar.getAttribute('data-name'));\n\t\t\t\t\t\n\t\t\t\t\t// Compare normalized values\n\t\t\t\t\tif (dataName === target && dataName.length > 0) {\n\t\t\t\t\t\tavatar.classList.add('active');\n\t\t\t\t\t} else {\n\t\t\t\t\t\tavatar.classList.remove('active');\n\t\t\t\t\t}\n\t\t\t\t});\n\t\t\t}\n\n\t\t\t// Update active avatar and clear button state on load\n\t\t\tdocument.addEventListener('DOMContentLoaded', function() {\n\t\t\t\tconst nameInput = document.getElementById('name');\n\t\t\t\tif (nameInput) {\n\t\t\t\t\ttoggleClearButton();\n\t\t\t\t\tupdateAvatarActive(nameInput.value);\n\t\t\t\t\tnameInput.addEventListener('input', function() {\n\t\t\t\t\t\tupdateAvatarActive(this.value);\n\t\t\t\t\t});\n\t\t\t\t}\n\t\t\t});\n\t\t\n\t\t\tfunction toggleClearButton() {\n\t\t\t\tconst nameInput = document.getElementById('name');\n\t\t\t\tconst clearBtn = document.getElementById('clear-btn');\n\t\t\t\tif (nameInput && nameInput.value.length > 0) {\n\t\t\t\t\tclearBtn.style.display = 'block';\n\t\t\t\t} else if (clearBtn) {\n\t\t\t\t\tclearBtn.style.display = 'none';\n\t\t\t\t}\n\t\t\t}\n\t\t\n\t\t\tfunction clearName() {\n\t\t\t\tconst nameInput = document.getElementById('name');\n\t\t\t\tnameInput.value = '';\n\t\t\t\ttoggleClearButton();\n\t\t\t\tnameInput.focus();\n\t\t\t\t// Manually trigger the input event to clear the results via HTMX\n\t\t\t\thtmx.trigger('#name', 'input');\n\t\t\t}\n\t\t\n\t\t\t// Back to Top functionality\n\t\t\tconst backToTopButton = document.getElementById(\"back-to-top\");\n\t\t\n\t\t\twindow.onscroll = function () {\n\t\t\t\tif (document.body.scrollTop > 200 || document.documentElement.scrollTop > 200) {\n\t\t\t\t\tbackToTopButton.style.display = \"flex\";\n\t\t\t\t} else {\n\t\t\t\t\tbackToTopButton.style.display = \"none\";\n\t\t\t\t}\n\t\t\t};\n\t\t\n\t\t\tfunction scrollToTop() {\n\t\t\t\twindow.scrollTo({ top: 0, behavior: 'smooth' });\n\t\t\t}\n\t\t\n\t\t\tdocument.addEventListener('DOMContentLoaded', function () {\n\t\t\t\ttoggleClearButton();\n\t\t\t\t// Trigger initial load from the input field\n\t\t\t\t// htmx.trigger('#name', 'load'); // REMOVED: Managed by streaming\n\t\t\t});\n\t\t</script>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}